@@ -57,18 +57,19 @@ type ConfResolver struct {
 
 // Distribution holds the parameters for the final binary
 type Distribution struct {
-	Module                   string `mapstructure:"module"`
-	Name                     string `mapstructure:"name"`
-	Go                       string `mapstructure:"go"`
-	Description              string `mapstructure:"description"`
-	OtelColVersion           string `mapstructure:"otelcol_version"`
-	RequireOtelColModule     bool   `mapstructure:"-"` // required for backwards-compatibility with builds older than 0.86.0
-	SupportsConfmapFactories bool   `mapstructure:"-"` // Required for backwards-compatibility with builds older than 0.99.0
-	SupportsComponentModules bool   `mapstructure:"-"` // Required for backwards-compatibility with builds older than 0.106.0
-	OutputPath               string `mapstructure:"output_path"`
-	Version                  string `mapstructure:"version"`
-	BuildTags                string `mapstructure:"build_tags"`
-	DebugCompilation         bool   `mapstructure:"debug_compilation"`
+	Module                     string `mapstructure:"module"`
+	Name                       string `mapstructure:"name"`
+	Go                         string `mapstructure:"go"`
+	Description                string `mapstructure:"description"`
+	OtelColVersion             string `mapstructure:"otelcol_version"`
+	RequireOtelColModule       bool   `mapstructure:"-"` // required for backwards-compatibility with builds older than 0.86.0
+	SupportsConfmapFactories   bool   `mapstructure:"-"` // Required for backwards-compatibility with builds older than 0.99.0
+	SupportsComponentModules   bool   `mapstructure:"-"` // Required for backwards-compatibility with builds older than 0.106.0
+	SupportsConfigDescriptions bool   `mapstructure:"-"` // Required for backwards-compatibility with builds older than 0.110.0
+	OutputPath                 string `mapstructure:"output_path"`
+	Version                    string `mapstructure:"version"`
+	BuildTags                  string `mapstructure:"build_tags"`
+	DebugCompilation           bool   `mapstructure:"debug_compilation"`
 }
 
 // Module represents a receiver, exporter, processor or extension for the distribution
@@ -175,6 +176,14 @@ func (c *Config) SetBackwardsCompatibility() error {
 
 	c.Distribution.SupportsComponentModules = constraint.Check(otelColVersion)
 
+	// check whether the config descriptions are supported by the schema command
+	constraint, err = version.NewConstraint(">= 0.110.0")
+	if err != nil {
+		return err
+	}
+
+	c.Distribution.SupportsConfigDescriptions = constraint.Check(otelColVersion)
+
 	return nil
 }
 
@@ -324,6 +324,35 @@ func TestConfmapFactoryVersions(t *testing.T) {
 	}
 }
 
+func TestConfigDescriptionsVersions(t *testing.T) {
+	testCases := []struct {
+		version   string
+		supported bool
+	}{
+		{
+			version:   "0.109.0",
+			supported: false,
+		},
+		{
+			version:   "0.110.0",
+			supported: true,
+		},
+		{
+			version:   "1.0.0",
+			supported: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.version, func(t *testing.T) {
+			cfg := NewDefaultConfig()
+			cfg.Distribution.OtelColVersion = tt.version
+			require.NoError(t, cfg.SetBackwardsCompatibility())
+			assert.Equal(t, tt.supported, cfg.Distribution.SupportsConfigDescriptions)
+		})
+	}
+}
+
 func TestAddsDefaultProviders(t *testing.T) {
 	cfg := NewDefaultConfig()
 	cfg.Providers = nil
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder // import "go.opentelemetry.io/collector/cmd/builder/internal/builder"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const configDescriptionsFile = "config_descriptions.go"
+
+// goPackage holds the fields of the output of `go list -json` used to find the config structs.
+type goPackage struct {
+	ImportPath string
+	Dir        string
+	GoFiles    []string
+	Standard   bool
+}
+
+// configDescription is the description of a config struct, or of one of its fields.
+type configDescription struct {
+	Key         string
+	Description string
+}
+
+// GenerateConfigDescriptions writes the doc comments of the config structs of the distribution dependencies,
+// so the schema command can describe the settings. It requires the modules to be downloaded.
+func GenerateConfigDescriptions(cfg Config) error {
+	if cfg.SkipGenerate || cfg.SkipGetModules || !cfg.Distribution.SupportsConfigDescriptions {
+		return nil
+	}
+
+	args := []string{"list", "-deps", "-json"}
+	if cfg.Distribution.BuildTags != "" {
+		args = append(args, "-tags", cfg.Distribution.BuildTags)
+	}
+	out, err := runGoCommand(cfg, append(args, ".")...)
+	if err != nil {
+		return fmt.Errorf("failed to list the packages of the distribution: %w", err)
+	}
+
+	var pkgs []goPackage
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var pkg goPackage
+		if err = dec.Decode(&pkg); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to decode the packages of the distribution: %w", err)
+		}
+		if !pkg.Standard {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+
+	descriptions := configDescriptions(cfg, pkgs)
+	if err = processAndWrite(cfg, configDescriptionsTemplate, configDescriptionsFile, descriptions); err != nil {
+		return fmt.Errorf("failed to generate source file %q: %w", configDescriptionsFile, err)
+	}
+	cfg.Logger.Info("Config descriptions created", zap.Int("descriptions", len(descriptions)))
+	return nil
+}
+
+// configDescriptions returns the doc comments of the structs having fields with a `mapstructure` tag,
+// and of these fields, keyed by their Go path, like "go.opentelemetry.io/collector/exporter/otlpexporter.Config.Timeout".
+// The packages that cannot be parsed are skipped.
+func configDescriptions(cfg Config, pkgs []goPackage) []configDescription {
+	var descriptions []configDescription
+	for _, pkg := range pkgs {
+		p, err := parsePackage(pkg)
+		if err != nil {
+			cfg.Logger.Info("Skipping the config descriptions of a package", zap.String("package", pkg.ImportPath), zap.Error(err))
+			continue
+		}
+		for _, t := range p.Types {
+			descriptions = append(descriptions, structDescriptions(pkg.ImportPath, t)...)
+		}
+	}
+	sort.Slice(descriptions, func(i, j int) bool {
+		return descriptions[i].Key < descriptions[j].Key
+	})
+	return descriptions
+}
+
+func parsePackage(pkg goPackage) (*doc.Package, error) {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(pkg.GoFiles))
+	for _, name := range pkg.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return doc.NewFromFiles(fset, files, pkg.ImportPath)
+}
+
+// structDescriptions returns the descriptions of the type t if it is a config struct.
+func structDescriptions(importPath string, t *doc.Type) []configDescription {
+	var st *ast.StructType
+	for _, spec := range t.Decl.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == t.Name {
+			st, _ = ts.Type.(*ast.StructType)
+		}
+	}
+	if st == nil {
+		return nil
+	}
+
+	key := importPath + "." + t.Name
+	var descriptions []configDescription
+	configStruct := false
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		if _, ok := reflect.StructTag(tag).Lookup("mapstructure"); !ok {
+			continue
+		}
+		configStruct = true
+		text := field.Doc.Text()
+		if text == "" {
+			text = field.Comment.Text()
+		}
+		if text = normalizeDoc(text); text == "" {
+			continue
+		}
+		// The embedded fields have no names, their own fields are described with their struct.
+		for _, name := range field.Names {
+			descriptions = append(descriptions, configDescription{Key: key + "." + name.Name, Description: text})
+		}
+	}
+	if !configStruct {
+		return nil
+	}
+	if text := normalizeDoc(t.Doc); text != "" {
+		descriptions = append(descriptions, configDescription{Key: key, Description: text})
+	}
+	return descriptions
+}
+
+// normalizeDoc joins the lines of a doc comment, the JSON schema descriptions being shown on a single line.
+func normalizeDoc(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const componentTestFile = `package testcomponent
+
+import "time"
+
+// Config defines the configuration of the test component.
+type Config struct {
+	ClientConfig ` + "`mapstructure:\",squash\"`" + `
+
+	// Timeout is how long to wait
+	// for a response.
+	Timeout time.Duration ` + "`mapstructure:\"timeout\"`" + `
+
+	Retries int ` + "`mapstructure:\"retries\"`" + ` // Retries is the number of attempts.
+
+	Untagged string
+}
+
+// ClientConfig holds the client settings.
+type ClientConfig struct {
+	// Endpoint is where the data is sent.
+	Endpoint string ` + "`mapstructure:\"endpoint\"`" + `
+}
+
+// State is not a config struct, its fields have no mapstructure tags.
+type State struct {
+	// Count is ignored.
+	Count int
+}
+`
+
+func TestConfigDescriptions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.go"), []byte(componentTestFile), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.go"), []byte("package invalid\n\nfunc {"), 0600))
+
+	cfg := newTestConfig()
+	descriptions := configDescriptions(cfg, []goPackage{
+		{ImportPath: "example.com/testcomponent", Dir: dir, GoFiles: []string{"config.go"}},
+		// The packages that cannot be parsed are skipped.
+		{ImportPath: "example.com/invalid", Dir: dir, GoFiles: []string{"invalid.go"}},
+	})
+	assert.Equal(t, []configDescription{
+		{Key: "example.com/testcomponent.ClientConfig", Description: "ClientConfig holds the client settings."},
+		{Key: "example.com/testcomponent.ClientConfig.Endpoint", Description: "Endpoint is where the data is sent."},
+		{Key: "example.com/testcomponent.Config", Description: "Config defines the configuration of the test component."},
+		{Key: "example.com/testcomponent.Config.Retries", Description: "Retries is the number of attempts."},
+		{Key: "example.com/testcomponent.Config.Timeout", Description: "Timeout is how long to wait for a response."},
+	}, descriptions)
+}
+
+func TestGenerateConfigDescriptionsFile(t *testing.T) {
+	cfg := newInitializedConfig(t)
+	cfg.Distribution.OutputPath = t.TempDir()
+	cfg.Distribution.OtelColVersion = "0.110.0"
+	require.NoError(t, cfg.SetBackwardsCompatibility())
+	require.NoError(t, Generate(cfg))
+
+	// The descriptions are empty until the modules are downloaded.
+	generated, err := os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, configDescriptionsFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "var configDescriptions = map[string]string{\n}")
+	main, err := os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, "main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(main), "ConfigDescriptions: configDescriptions,")
+
+	require.NoError(t, processAndWrite(cfg, configDescriptionsTemplate, configDescriptionsFile, []configDescription{
+		{Key: "example.com/testcomponent.Config", Description: `Config of the "test" component.`},
+	}))
+	generated, err = os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, configDescriptionsFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), `	"example.com/testcomponent.Config": "Config of the \"test\" component.",`)
+}
+
+func TestGenerateConfigDescriptions(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Distribution.OutputPath = t.TempDir()
+	cfg.Distribution.OtelColVersion = "0.110.0"
+	cfg.SkipStrictVersioning = true
+	cfg.Replaces = append(cfg.Replaces, generateReplaces()...)
+	require.NoError(t, cfg.SetBackwardsCompatibility())
+	require.NoError(t, cfg.Validate())
+	require.NoError(t, cfg.SetGoPath())
+	require.NoError(t, cfg.ParseModules())
+	require.NoError(t, GenerateAndCompile(cfg))
+
+	generated, err := os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, configDescriptionsFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(generated),
+		`	"go.opentelemetry.io/collector/service.Config.Pipelines": "Pipelines are the set of data pipelines configured for the service.",`)
+}
@@ -64,6 +64,11 @@ func GenerateAndCompile(cfg Config) error {
 		return err
 	}
 
+	// the sources of the components are needed to describe their configs
+	if err := GenerateConfigDescriptions(cfg); err != nil {
+		return err
+	}
+
 	return Compile(cfg)
 }
 
@@ -98,6 +103,13 @@ func Generate(cfg Config) error {
 		}
 	}
 
+	// The config descriptions are filled once the modules are downloaded, see GenerateConfigDescriptions.
+	if cfg.Distribution.SupportsConfigDescriptions {
+		if err := processAndWrite(cfg, configDescriptionsTemplate, configDescriptionsFile, []configDescription(nil)); err != nil {
+			return fmt.Errorf("failed to generate source file %q: %w", configDescriptionsFile, err)
+		}
+	}
+
 	cfg.Logger.Info("Sources created", zap.String("path", cfg.Distribution.OutputPath))
 	return nil
 }
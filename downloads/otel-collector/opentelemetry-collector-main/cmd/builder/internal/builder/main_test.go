@@ -59,6 +59,7 @@ var (
 		"/confmap/provider/fileprovider",
 		"/confmap/provider/httpprovider",
 		"/confmap/provider/httpsprovider",
+		"/confmap/provider/internal/watcher",
 		"/confmap/provider/yamlprovider",
 		"/consumer",
 		"/consumer/consumerprofiles",
@@ -78,10 +79,12 @@ var (
 		"/extension/zpagesextension",
 		"/featuregate",
 		"/internal/globalgates",
+		"/internal/otlptext",
 		"/processor",
 		"/processor/batchprocessor",
 		"/processor/memorylimiterprocessor",
 		"/processor/processorprofiles",
+		"/processor/processorhelper/processorhelperprofiles",
 		"/receiver",
 		"/receiver/nopreceiver",
 		"/receiver/otlpreceiver",
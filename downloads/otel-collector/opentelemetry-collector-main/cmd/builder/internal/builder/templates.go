@@ -25,6 +25,10 @@ var (
 	mainWindowsBytes    []byte
 	mainWindowsTemplate = parseTemplate("main_windows.go", mainWindowsBytes)
 
+	//go:embed templates/config_descriptions.go.tmpl
+	configDescriptionsBytes    []byte
+	configDescriptionsTemplate = parseTemplate(configDescriptionsFile, configDescriptionsBytes)
+
 	//go:embed templates/go.mod.tmpl
 	goModBytes    []byte
 	goModTemplate = parseTemplate("go.mod", goModBytes)
@@ -93,6 +93,7 @@ require (
 	go.opentelemetry.io/collector/config/configtelemetry v0.109.0 // indirect
 	go.opentelemetry.io/collector/config/configtls v1.15.0 // indirect
 	go.opentelemetry.io/collector/config/internal v0.109.1-0.20240916143658-74729e731d3b // indirect
+	go.opentelemetry.io/collector/confmap/provider/internal/watcher v1.15.0 // indirect
 	go.opentelemetry.io/collector/connector/connectorprofiles v0.109.0 // indirect
 	go.opentelemetry.io/collector/consumer v0.109.0 // indirect
 	go.opentelemetry.io/collector/consumer/consumerprofiles v0.109.0 // indirect
@@ -103,9 +104,11 @@ require (
 	go.opentelemetry.io/collector/extension/extensioncapabilities v0.109.0 // indirect
 	go.opentelemetry.io/collector/featuregate v1.15.0 // indirect
 	go.opentelemetry.io/collector/internal/globalgates v0.109.0 // indirect
+	go.opentelemetry.io/collector/internal/otlptext v0.109.0 // indirect
 	go.opentelemetry.io/collector/pdata v1.15.0 // indirect
 	go.opentelemetry.io/collector/pdata/pprofile v0.109.0 // indirect
 	go.opentelemetry.io/collector/pdata/testdata v0.109.0 // indirect
+	go.opentelemetry.io/collector/processor/processorhelper/processorhelperprofiles v0.109.0 // indirect
 	go.opentelemetry.io/collector/processor/processorprofiles v0.109.0 // indirect
 	go.opentelemetry.io/collector/receiver/receiverprofiles v0.109.0 // indirect
 	go.opentelemetry.io/collector/semconv v0.109.0 // indirect
@@ -150,6 +153,8 @@ replace go.opentelemetry.io/collector => ../../
 
 replace go.opentelemetry.io/collector/internal/globalgates => ../../internal/globalgates
 
+replace go.opentelemetry.io/collector/internal/otlptext => ../../internal/otlptext
+
 replace go.opentelemetry.io/collector/client => ../../client
 
 replace go.opentelemetry.io/collector/otelcol => ../../otelcol
@@ -246,6 +251,8 @@ replace go.opentelemetry.io/collector/processor/memorylimiterprocessor => ../../
 
 replace go.opentelemetry.io/collector/processor/processorprofiles => ../../processor/processorprofiles
 
+replace go.opentelemetry.io/collector/processor/processorhelper/processorhelperprofiles => ../../processor/processorhelper/processorhelperprofiles
+
 replace go.opentelemetry.io/collector/receiver => ../../receiver
 
 replace go.opentelemetry.io/collector/receiver/nopreceiver => ../../receiver/nopreceiver
@@ -257,3 +264,5 @@ replace go.opentelemetry.io/collector/receiver/receiverprofiles => ../../receive
 replace go.opentelemetry.io/collector/semconv => ../../semconv
 
 replace go.opentelemetry.io/collector/service => ../../service
+
+replace go.opentelemetry.io/collector/confmap/provider/internal/watcher => ../../confmap/provider/internal/watcher
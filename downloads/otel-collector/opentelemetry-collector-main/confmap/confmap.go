@@ -46,6 +46,8 @@ type Conf struct {
 	// This avoids running into an infinite recursion where Unmarshaler.Unmarshal and
 	// Conf.Unmarshal would call each other.
 	skipTopLevelUnmarshaler bool
+	// origins holds where the keys were set, by key, when the Conf is returned by the Resolver.
+	origins map[string][]Origin
 }
 
 // AllKeys returns all keys holding a value, regardless of where they are set.
@@ -211,6 +211,10 @@ func (mr *Resolver) expandURI(ctx context.Context, input string) (*Retrieved, er
 	if strings.Contains(lURI.opaqueValue, "$") {
 		return nil, fmt.Errorf("the uri %q contains unsupported characters ('$')", lURI.asString())
 	}
+	mr.expansions = append(mr.expansions, "${"+lURI.asString()+"}")
+	if lURI.scheme == templateScheme {
+		return mr.expandTemplate(lURI.opaqueValue)
+	}
 	ret, err := mr.retrieveValue(ctx, lURI)
 	if err != nil {
 		return nil, err
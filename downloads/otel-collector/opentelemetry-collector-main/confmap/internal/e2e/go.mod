@@ -12,6 +12,7 @@ require (
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/go-viper/mapstructure/v2 v2.1.0 // indirect
 	github.com/knadh/koanf/maps v0.1.1 // indirect
 	github.com/knadh/koanf/providers/confmap v0.1.0 // indirect
@@ -19,8 +20,10 @@ require (
 	github.com/mitchellh/copystructure v1.2.0 // indirect
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.opentelemetry.io/collector/confmap/provider/internal/watcher v1.15.0 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
 	go.uber.org/zap v1.27.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
@@ -31,3 +34,5 @@ replace go.opentelemetry.io/collector/confmap/provider/fileprovider => ../../pro
 replace go.opentelemetry.io/collector/confmap/provider/envprovider => ../../provider/envprovider
 
 replace go.opentelemetry.io/collector/config/configopaque => ../../../config/configopaque
+
+replace go.opentelemetry.io/collector/confmap/provider/internal/watcher => ../../provider/internal/watcher
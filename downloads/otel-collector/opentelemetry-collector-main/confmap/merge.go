@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap // import "go.opentelemetry.io/collector/confmap"
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/knadh/koanf/providers/confmap"
+	"github.com/knadh/koanf/v2"
+)
+
+// MergeMode defines how the lists are merged when a configuration is merged into another one.
+// The maps are always merged key by key, and the other values of the merged configuration always
+// replace the existing ones.
+type MergeMode string
+
+const (
+	// MergeModeReplace replaces the existing lists with the merged ones. This is the default mode.
+	MergeModeReplace MergeMode = "replace"
+	// MergeModeAppend appends the elements of the merged lists to the existing lists.
+	MergeModeAppend MergeMode = "append"
+	// MergeModeAppendUnique appends the elements of the merged lists to the existing lists,
+	// except the elements that are already present in them.
+	//
+	// Two strings, like component IDs, are the same element if they are equal once their surrounding
+	// whitespace is trimmed: "otlp" and " otlp" are the same, while "otlp" and "otlp/2" are different.
+	// The other elements, like maps, are the same if their whole content is equal. The existing
+	// elements keep their position, and only the first occurrence of a merged element is appended.
+	MergeModeAppendUnique MergeMode = "append-unique"
+)
+
+// UnmarshalText implements encoding.TextUnmarshaler, so the MergeMode can be set from a flag.
+func (m *MergeMode) UnmarshalText(text []byte) error {
+	mode := MergeMode(text)
+	switch mode {
+	case MergeModeReplace, MergeModeAppend, MergeModeAppendUnique:
+		*m = mode
+		return nil
+	}
+	return fmt.Errorf("unknown merge mode %q, must be one of %q, %q or %q", mode, MergeModeReplace, MergeModeAppend, MergeModeAppendUnique)
+}
+
+// MergeWithMode merges the input given configuration into the existing config, like Merge,
+// but merges the lists according to the given mode.
+// An empty mode is the same as MergeModeReplace.
+func (l *Conf) MergeWithMode(in *Conf, mode MergeMode) error {
+	switch mode {
+	case "", MergeModeReplace:
+		return l.Merge(in)
+	case MergeModeAppend, MergeModeAppendUnique:
+		return l.k.Load(confmap.Provider(in.k.Raw(), ""), nil, koanf.WithMergeFunc(func(src, dest map[string]any) error {
+			mergeMaps(src, dest, mode)
+			return nil
+		}))
+	}
+	return fmt.Errorf("unknown merge mode %q", mode)
+}
+
+// mergeMaps recursively merges src into dest, appending the lists of src to the lists of dest.
+func mergeMaps(src, dest map[string]any, mode MergeMode) {
+	for key, srcVal := range src {
+		destVal, ok := dest[key]
+		if !ok {
+			dest[key] = srcVal
+			continue
+		}
+		switch s := srcVal.(type) {
+		case map[string]any:
+			if d, isMap := destVal.(map[string]any); isMap {
+				mergeMaps(s, d, mode)
+				continue
+			}
+		case []any:
+			if d, isList := destVal.([]any); isList {
+				dest[key] = mergeLists(d, s, mode)
+				continue
+			}
+		}
+		dest[key] = srcVal
+	}
+}
+
+func mergeLists(dest, src []any, mode MergeMode) []any {
+	merged := make([]any, 0, len(dest)+len(src))
+	merged = append(merged, dest...)
+	for _, elem := range src {
+		if mode == MergeModeAppendUnique && containsElement(merged, elem) {
+			continue
+		}
+		merged = append(merged, elem)
+	}
+	return merged
+}
+
+func containsElement(list []any, elem any) bool {
+	for _, e := range list {
+		if sameElement(e, elem) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameElement(a, b any) bool {
+	as, aIsString := a.(string)
+	bs, bIsString := b.(string)
+	if aIsString && bIsString {
+		return strings.TrimSpace(as) == strings.TrimSpace(bs)
+	}
+	return reflect.DeepEqual(a, b)
+}
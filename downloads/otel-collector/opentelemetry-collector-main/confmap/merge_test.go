@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeWithMode(t *testing.T) {
+	base := map[string]any{
+		"receivers": map[string]any{"otlp": nil},
+		"service": map[string]any{
+			"extensions": []any{"health_check"},
+			"pipelines": map[string]any{
+				"traces": map[string]any{
+					"receivers": []any{"otlp"},
+					"exporters": []any{"otlp", "debug"},
+				},
+			},
+		},
+	}
+	overlay := map[string]any{
+		"exporters": map[string]any{"otlp/team": nil},
+		"service": map[string]any{
+			"extensions": "invalid",
+			"pipelines": map[string]any{
+				"traces": map[string]any{
+					"exporters": []any{" debug", "otlp/team", "otlp/team"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		mode              MergeMode
+		expectedExporters []any
+	}{
+		{
+			mode:              "",
+			expectedExporters: []any{" debug", "otlp/team", "otlp/team"},
+		},
+		{
+			mode:              MergeModeReplace,
+			expectedExporters: []any{" debug", "otlp/team", "otlp/team"},
+		},
+		{
+			mode:              MergeModeAppend,
+			expectedExporters: []any{"otlp", "debug", " debug", "otlp/team", "otlp/team"},
+		},
+		{
+			mode:              MergeModeAppendUnique,
+			expectedExporters: []any{"otlp", "debug", "otlp/team"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			conf := NewFromStringMap(base)
+			require.NoError(t, conf.MergeWithMode(NewFromStringMap(overlay), tt.mode))
+			assert.Equal(t, map[string]any{
+				"receivers": map[string]any{"otlp": nil},
+				"exporters": map[string]any{"otlp/team": nil},
+				"service": map[string]any{
+					// A value that is not a list always replaces the list.
+					"extensions": "invalid",
+					"pipelines": map[string]any{
+						"traces": map[string]any{
+							"receivers": []any{"otlp"},
+							"exporters": tt.expectedExporters,
+						},
+					},
+				},
+			}, conf.ToStringMap())
+			// The merged configurations are not modified.
+			assert.Equal(t, []any{"otlp", "debug"}, base["service"].(map[string]any)["pipelines"].(map[string]any)["traces"].(map[string]any)["exporters"])
+		})
+	}
+}
+
+func TestMergeWithModeListOfMaps(t *testing.T) {
+	conf := NewFromStringMap(map[string]any{
+		"headers": []any{map[string]any{"name": "a", "value": "1"}},
+	})
+	require.NoError(t, conf.MergeWithMode(NewFromStringMap(map[string]any{
+		"headers": []any{map[string]any{"name": "a", "value": "1"}, map[string]any{"name": "a", "value": "2"}},
+	}), MergeModeAppendUnique))
+	assert.Equal(t, map[string]any{
+		"headers": []any{map[string]any{"name": "a", "value": "1"}, map[string]any{"name": "a", "value": "2"}},
+	}, conf.ToStringMap())
+}
+
+func TestMergeWithModeUnknown(t *testing.T) {
+	assert.EqualError(t, New().MergeWithMode(New(), "prepend"), `unknown merge mode "prepend"`)
+}
+
+func TestMergeModeUnmarshalText(t *testing.T) {
+	for _, mode := range []MergeMode{MergeModeReplace, MergeModeAppend, MergeModeAppendUnique} {
+		var m MergeMode
+		require.NoError(t, m.UnmarshalText([]byte(mode)))
+		assert.Equal(t, mode, m)
+	}
+	var m MergeMode
+	assert.EqualError(t, m.UnmarshalText([]byte("prepend")), `unknown merge mode "prepend", must be one of "replace", "append" or "append-unique"`)
+	assert.Equal(t, MergeMode(""), m)
+}
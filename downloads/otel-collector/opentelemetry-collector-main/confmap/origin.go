@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap // import "go.opentelemetry.io/collector/confmap"
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Origin describes where a key of the configuration was set.
+type Origin struct {
+	// Key is the key, with KeyDelimiter separators, like "receivers::otlp".
+	Key string
+	// URI is the URI of the configuration the key was set in, like "file:/etc/otelcol/config.yaml".
+	URI string
+	// File is the file the key was set in, when the URI refers to several files, like a directory.
+	File string
+	// Line is the line of the key in its YAML document, or 0 if unknown.
+	Line int
+	// Expansions are the ${...} references the value of the key was expanded from, like "${env:ENDPOINT}".
+	Expansions []string
+}
+
+// String returns the location of the origin, like "file:/etc/otelcol/config.yaml:12",
+// followed by the references the value was expanded from, if any.
+func (o Origin) String() string {
+	var b strings.Builder
+	b.WriteString(o.URI)
+	switch {
+	case o.File != "":
+		b.WriteString(" (" + o.File)
+		if o.Line > 0 {
+			b.WriteString(":" + strconv.Itoa(o.Line))
+		}
+		b.WriteString(")")
+	case o.Line > 0:
+		b.WriteString(":" + strconv.Itoa(o.Line))
+	}
+	if len(o.Expansions) > 0 {
+		b.WriteString(" expanded from " + strings.Join(o.Expansions, ", "))
+	}
+	return b.String()
+}
+
+// WithRetrievedOrigins sets the origins of the keys of the retrieved configuration, by key, for the providers
+// reading it from several files. Only the File and Line of the origins are used, the Resolver sets the other fields.
+func WithRetrievedOrigins(origins map[string]Origin) RetrievedOption {
+	return retrievedOptionFunc(func(settings *retrievedSettings) {
+		settings.origins = origins
+	})
+}
+
+// Origins returns where the key, and the keys under it, were set, as recorded by the Resolver. They are sorted by key,
+// and in the order the configurations were merged for each key: the keys holding a map can be set by several
+// configurations. If nothing was recorded for the key and the keys under it, like for the content of a map retrieved
+// by an embedded URI, the origins of the closest parent key are returned.
+//
+// It returns nil if the Conf was not returned by the Resolver.
+func (l *Conf) Origins(key string) []Origin {
+	if len(l.origins) == 0 {
+		return nil
+	}
+	keys := make([]string, 0)
+	for k := range l.origins {
+		if k == key || strings.HasPrefix(k, key+KeyDelimiter) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		for i := strings.LastIndex(key, KeyDelimiter); i > 0; i = strings.LastIndex(key, KeyDelimiter) {
+			key = key[:i]
+			if origins, ok := l.origins[key]; ok {
+				return append([]Origin(nil), origins...)
+			}
+		}
+		return nil
+	}
+	sort.Strings(keys)
+	var origins []Origin
+	for _, k := range keys {
+		origins = append(origins, l.origins[k]...)
+	}
+	return origins
+}
+
+// recordOrigins records the origins of the keys of the configuration retrieved from the URI: the keys holding a map
+// are set by all the configurations setting them, and the other ones by the last configuration only.
+func (mr *Resolver) recordOrigins(origins map[string][]Origin, uri string, ret *Retrieved, prefix string, m map[string]any) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + KeyDelimiter + k
+		}
+		origin := Origin{Key: key, URI: uri}
+		if retOrigin, ok := ret.origins[key]; ok {
+			origin.File, origin.Line = retOrigin.File, retOrigin.Line
+		}
+
+		sub, isMap := v.(map[string]any)
+		_, isList := v.([]any)
+		switch {
+		case isMap:
+			origins[key] = append(origins[key], origin)
+			mr.recordOrigins(origins, uri, ret, key, sub)
+		case isList && mr.mergeMode != "" && mr.mergeMode != MergeModeReplace:
+			// The lists are merged too.
+			origins[key] = append(origins[key], origin)
+		default:
+			// The value replaces the previous one, with the keys under it if it was a map.
+			for other := range origins {
+				if strings.HasPrefix(other, key+KeyDelimiter) {
+					delete(origins, other)
+				}
+			}
+			origins[key] = []Origin{origin}
+		}
+	}
+}
+
+// yamlOrigins returns the lines of the keys of the YAML document, if it holds a map.
+func yamlOrigins(yamlBytes []byte) map[string]Origin {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(yamlBytes, &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	origins := make(map[string]Origin)
+	addYAMLOrigins(origins, "", doc.Content[0])
+	return origins
+}
+
+func addYAMLOrigins(origins map[string]Origin, prefix string, node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		if keyNode.Value == "<<" {
+			// The merged anchors are not tracked.
+			continue
+		}
+		key := keyNode.Value
+		if prefix != "" {
+			key = prefix + KeyDelimiter + key
+		}
+		origins[key] = Origin{Key: key, Line: keyNode.Line}
+		addYAMLOrigins(origins, key, valueNode)
+	}
+}
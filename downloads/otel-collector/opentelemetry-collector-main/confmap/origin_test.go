@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	originsBase = `receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: ${env:ENDPOINT}
+exporters:
+  debug:
+    verbosity: basic
+`
+	originsOverride = `exporters:
+  debug:
+    verbosity: ${env:VERBOSITY}
+  otlp:
+    endpoint: localhost:4317
+`
+	originsFlat = `receivers: {}
+exporters:
+  debug: null
+`
+)
+
+var originsSources = map[string]string{"base": originsBase, "override": originsOverride, "flat": originsFlat}
+
+func newOriginsResolver(t *testing.T, mode MergeMode, uris ...string) *Resolver {
+	yamlProvider := newFakeProvider("yaml", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrievedFromYAML([]byte(originsSources[uri[len("yaml:"):]]))
+	})
+	envProvider := newFakeProvider("env", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{"ENDPOINT": "0.0.0.0:4317", "VERBOSITY": "detailed"}[uri[len("env:"):]])
+	})
+	resolver, err := NewResolver(ResolverSettings{
+		URIs:              uris,
+		ProviderFactories: []ProviderFactory{yamlProvider, envProvider},
+		MergeMode:         mode,
+	})
+	require.NoError(t, err)
+	return resolver
+}
+
+func TestResolverOrigins(t *testing.T) {
+	conf, err := newOriginsResolver(t, MergeModeReplace, "yaml:base", "yaml:override").Resolve(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []Origin{
+		{Key: "receivers::otlp::protocols::grpc::endpoint", URI: "yaml:base", Line: 5, Expansions: []string{"${env:ENDPOINT}"}},
+	}, conf.Origins("receivers::otlp::protocols::grpc::endpoint"))
+
+	assert.Equal(t, []Origin{
+		{Key: "exporters::debug", URI: "yaml:base", Line: 7},
+		{Key: "exporters::debug", URI: "yaml:override", Line: 2},
+		{Key: "exporters::debug::verbosity", URI: "yaml:override", Line: 3, Expansions: []string{"${env:VERBOSITY}"}},
+	}, conf.Origins("exporters::debug"))
+
+	assert.Equal(t, []Origin{
+		{Key: "exporters::otlp", URI: "yaml:override", Line: 4},
+		{Key: "exporters::otlp::endpoint", URI: "yaml:override", Line: 5},
+	}, conf.Origins("exporters::otlp"))
+
+	// The keys not recorded use the origins of their closest parent.
+	assert.Equal(t, []Origin{
+		{Key: "exporters::otlp", URI: "yaml:override", Line: 4},
+	}, conf.Origins("exporters::otlp::tls"))
+	assert.Nil(t, conf.Origins("processors"))
+	assert.Nil(t, New().Origins("exporters"))
+}
+
+func TestResolverOriginsReplacedMap(t *testing.T) {
+	conf, err := newOriginsResolver(t, MergeModeReplace, "yaml:base", "yaml:flat").Resolve(context.Background())
+	require.NoError(t, err)
+	// The value set by the last configuration replaces the keys under it.
+	assert.Equal(t, []Origin{{Key: "exporters::debug", URI: "yaml:flat", Line: 3}}, conf.Origins("exporters::debug"))
+}
+
+func TestRetrievedOrigins(t *testing.T) {
+	ret, err := NewRetrievedFromYAML([]byte("a:\n  b: 1\n  <<: {c: 2}\nd: [1, 2]\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Origin{
+		"a":    {Key: "a", Line: 1},
+		"a::b": {Key: "a::b", Line: 2},
+		"d":    {Key: "d", Line: 4},
+	}, ret.origins)
+
+	ret, err = NewRetrievedFromYAML([]byte("a: 1\n"), WithRetrievedOrigins(map[string]Origin{"a": {File: "conf.d/a.yaml", Line: 3}}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Origin{"a": {File: "conf.d/a.yaml", Line: 3}}, ret.origins)
+
+	ret, err = NewRetrievedFromYAML([]byte("value"))
+	require.NoError(t, err)
+	assert.Nil(t, ret.origins)
+}
+
+func TestOriginString(t *testing.T) {
+	assert.Equal(t, "file:config.yaml", Origin{URI: "file:config.yaml"}.String())
+	assert.Equal(t, "file:config.yaml:12", Origin{URI: "file:config.yaml", Line: 12}.String())
+	assert.Equal(t, "dir:conf.d (conf.d/a.yaml:3)", Origin{URI: "dir:conf.d", File: "conf.d/a.yaml", Line: 3}.String())
+	assert.Equal(t, "file:config.yaml:5 expanded from ${env:A}, ${env:B}",
+		Origin{URI: "file:config.yaml", Line: 5, Expansions: []string{"${env:A}", "${env:B}"}}.String())
+}
@@ -100,6 +100,8 @@ type ChangeEvent struct {
 type Retrieved struct {
 	rawConf   any
 	closeFunc CloseFunc
+	// origins holds the origins of the keys of rawConf, by key.
+	origins map[string]Origin
 
 	stringRepresentation string
 	isSetString          bool
@@ -109,6 +111,7 @@ type retrievedSettings struct {
 	stringRepresentation string
 	isSetString          bool
 	closeFunc            CloseFunc
+	origins              map[string]Origin
 }
 
 // RetrievedOption options to customize Retrieved values.
@@ -152,6 +155,10 @@ func NewRetrievedFromYAML(yamlBytes []byte, opts ...RetrievedOption) (*Retrieved
 	case string:
 		val := string(yamlBytes)
 		return NewRetrieved(val, append(opts, withStringRepresentation(val))...)
+	case map[string]any:
+		// The lines of the keys are recorded, unless the origins are given by the options.
+		opts = append([]RetrievedOption{WithRetrievedOrigins(yamlOrigins(yamlBytes))}, opts...)
+		opts = append(opts, withStringRepresentation(string(yamlBytes)))
 	default:
 		opts = append(opts, withStringRepresentation(string(yamlBytes)))
 	}
@@ -175,6 +182,7 @@ func NewRetrieved(rawConf any, opts ...RetrievedOption) (*Retrieved, error) {
 	return &Retrieved{
 		rawConf:              rawConf,
 		closeFunc:            set.closeFunc,
+		origins:              set.origins,
 		stringRepresentation: set.stringRepresentation,
 		isSetString:          set.isSetString,
 	}, nil
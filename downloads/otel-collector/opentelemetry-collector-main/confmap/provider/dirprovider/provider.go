@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dirprovider // import "go.opentelemetry.io/collector/confmap/provider/dirprovider"
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/internal/watcher"
+)
+
+const (
+	schemeName = "dir"
+
+	// PollIntervalEnvVar is the environment variable setting the interval at which the retrieved directories are
+	// polled for changes, like "30s", when WithPollInterval is not used.
+	PollIntervalEnvVar = "OTELCOL_DIR_PROVIDER_POLL_INTERVAL"
+
+	defaultDebounce = 500 * time.Millisecond
+)
+
+type provider struct {
+	logger       *zap.Logger
+	pollInterval time.Duration
+	debounce     time.Duration
+}
+
+// Option configures the directory provider.
+type Option func(*provider)
+
+// WithPollInterval makes the provider poll the retrieved directories for changes every interval,
+// instead of relying on the file system notifications. Polling can be needed on file systems
+// that don't support notifications, like some network file systems.
+// It overrides the interval set by the PollIntervalEnvVar environment variable.
+func WithPollInterval(interval time.Duration) Option {
+	return func(p *provider) {
+		p.pollInterval = interval
+	}
+}
+
+// WithDebounce sets how long the files of a retrieved directory must be left unchanged
+// before the watcher is called, so the files written one after the other result in a single reload.
+// The default is 500ms.
+func WithDebounce(debounce time.Duration) Option {
+	return func(p *provider) {
+		p.debounce = debounce
+	}
+}
+
+// NewFactory returns a factory for a confmap.Provider that reads the configuration from the YAML files of a directory.
+//
+// This Provider supports "dir" scheme, and can be called with a "uri" that follows:
+//
+//	dir-uri			= "dir:" local-path
+//	local-path		= [ drive-letter ] dir-path
+//	drive-letter	= ALPHA ":"
+//
+// The "dir-path" can be relative or absolute, and it can be any OS supported format.
+//
+// The files of the directory with the ".yaml" or ".yml" extension are read in the lexical order of their names,
+// and merged with confmap.Conf.Merge: the maps are merged key by key, and the other values of a file replace the
+// values of the previous files. The subdirectories and the hidden files, whose name starts with a dot, are ignored.
+//
+// Examples:
+// `dir:path/to/conf.d` - relative path (unix, windows)
+// `dir:/etc/otelcol/conf.d` - absolute path (unix, windows)
+// `dir:c:\otelcol\conf.d` - absolute path including drive-letter (windows)
+//
+// When Retrieve is given a watcher, the directory is watched until the returned confmap.Retrieved is closed,
+// and the watcher is called once a file is added, removed or changed. The directory is polled instead at the
+// interval set by WithPollInterval, or by the PollIntervalEnvVar environment variable.
+func NewFactory(opts ...Option) confmap.ProviderFactory {
+	return confmap.NewProviderFactory(func(set confmap.ProviderSettings) confmap.Provider {
+		return newProvider(set, opts...)
+	})
+}
+
+func newProvider(set confmap.ProviderSettings, opts ...Option) confmap.Provider {
+	p := &provider{
+		logger:   set.Logger,
+		debounce: defaultDebounce,
+	}
+	p.pollInterval = watcher.PollIntervalFromEnv(p.logger, PollIntervalEnvVar)
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (dp *provider) Retrieve(_ context.Context, uri string, onChange confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+
+	// Clean the path before using it.
+	dir := filepath.Clean(uri[len(schemeName)+1:])
+	files, err := readFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the directory %v: %w", uri, err)
+	}
+
+	conf, origins, err := dp.merge(files)
+	if err != nil {
+		return nil, err
+	}
+
+	if onChange == nil {
+		return confmap.NewRetrieved(conf.ToStringMap(), confmap.WithRetrievedOrigins(origins))
+	}
+
+	dw := watcher.Watch(watcher.WatchSettings{
+		Name:         "directory",
+		Dir:          dir,
+		Hash:         hashFiles(files),
+		ReadHash:     func() ([sha256.Size]byte, error) { return readHash(dir) },
+		Logger:       dp.logger.With(zap.String("dir", dir)),
+		PollInterval: dp.pollInterval,
+		Debounce:     dp.debounce,
+	}, onChange)
+	return confmap.NewRetrieved(conf.ToStringMap(), confmap.WithRetrievedOrigins(origins), confmap.WithRetrievedClose(dw.Close))
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (*provider) Shutdown(context.Context) error {
+	return nil
+}
+
+// file is a configuration file of a directory.
+type file struct {
+	path    string
+	content []byte
+}
+
+// readFiles returns the YAML files of the directory, in the lexical order of their names.
+func readFiles(dir string) ([]file, error) {
+	// The entries are sorted by name.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []file
+	for _, entry := range entries {
+		if !isConfigFile(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		// Stat follows the symbolic links, like the ones of the Kubernetes ConfigMap volumes.
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file{path: path, content: content})
+	}
+	return files, nil
+}
+
+// isConfigFile returns whether the file with the given name is a configuration file.
+func isConfigFile(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	ext := filepath.Ext(name)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// merge merges the files in their order, and returns the file and line each key was last set at.
+// The keys set by several files are logged, since only the value of the last file is used.
+func (dp *provider) merge(files []file) (*confmap.Conf, map[string]confmap.Origin, error) {
+	conf := confmap.New()
+	origins := make(map[string]confmap.Origin)
+	for _, f := range files {
+		ret, err := confmap.NewRetrievedFromYAML(f.content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse the file %v: %w", f.path, err)
+		}
+		fileConf, err := ret.AsConf()
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to use the file %v as a configuration: %w", f.path, err)
+		}
+		for _, key := range fileConf.AllKeys() {
+			if origin, ok := origins[key]; ok {
+				dp.logger.Warn("Configuration key set by several files, the value of the last file is used",
+					zap.String("key", key), zap.String("file", origin.File), zap.String("overridden_by", f.path))
+			}
+		}
+		addOrigins(origins, f.path, f.content)
+		if err = conf.Merge(fileConf); err != nil {
+			return nil, nil, fmt.Errorf("unable to merge the file %v: %w", f.path, err)
+		}
+	}
+	return conf, origins, nil
+}
+
+// addOrigins sets the origin of the keys of the YAML file to the file and the line of the key.
+func addOrigins(origins map[string]confmap.Origin, path string, content []byte) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return
+	}
+	var walk func(prefix string, node *yaml.Node)
+	walk = func(prefix string, node *yaml.Node) {
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if prefix != "" {
+				key = prefix + confmap.KeyDelimiter + key
+			}
+			origins[key] = confmap.Origin{Key: key, File: path, Line: node.Content[i].Line}
+			walk(key, node.Content[i+1])
+		}
+	}
+	walk("", doc.Content[0])
+}
+
+func readHash(dir string) ([sha256.Size]byte, error) {
+	files, err := readFiles(dir)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return hashFiles(files), nil
+}
+
+// hashFiles returns a hash of the names and contents of the files, to find out when they changed.
+func hashFiles(files []file) [sha256.Size]byte {
+	h := sha256.New()
+	for _, f := range files {
+		contentHash := sha256.Sum256(f.content)
+		h.Write([]byte(filepath.Base(f.path)))
+		h.Write([]byte{0})
+		h.Write(contentHash[:])
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
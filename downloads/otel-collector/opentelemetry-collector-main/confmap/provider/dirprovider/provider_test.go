@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dirprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+const dirSchemePrefix = schemeName + ":"
+
+func createProvider(opts ...Option) confmap.Provider {
+	return NewFactory(opts...).Create(confmaptest.NewNopProviderSettings())
+}
+
+func TestValidateProviderScheme(t *testing.T) {
+	assert.NoError(t, confmaptest.ValidateProviderScheme(createProvider()))
+}
+
+func TestUnsupportedScheme(t *testing.T) {
+	dp := createProvider()
+	_, err := dp.Retrieve(context.Background(), "file:testdata", nil)
+	require.Error(t, err)
+	assert.NoError(t, dp.Shutdown(context.Background()))
+}
+
+func TestNonExistent(t *testing.T) {
+	dp := createProvider()
+	_, err := dp.Retrieve(context.Background(), dirSchemePrefix+filepath.Join("testdata", "non-existent"), nil)
+	require.Error(t, err)
+	assert.NoError(t, dp.Shutdown(context.Background()))
+}
+
+func TestRetrieve(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	dp := NewFactory().Create(confmap.ProviderSettings{Logger: zap.New(core)})
+	ret, err := dp.Retrieve(context.Background(), dirSchemePrefix+filepath.Join("testdata", "conf.d"), nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"receivers": map[string]any{
+			"otlp": map[string]any{"protocols": map[string]any{"grpc": nil}},
+		},
+		"exporters": map[string]any{
+			// Set by the last file.
+			"otlp": map[string]any{"endpoint": "team-b-backend:4317"},
+		},
+		"service": map[string]any{
+			"pipelines": map[string]any{
+				"traces":        map[string]any{"receivers": []any{"otlp"}, "exporters": []any{"otlp"}},
+				"traces/team-a": map[string]any{"receivers": []any{"otlp"}, "exporters": []any{"otlp"}},
+			},
+		},
+	}, raw)
+
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, map[string]any{
+		"key":           "exporters::otlp::endpoint",
+		"file":          filepath.Join("testdata", "conf.d", "10-base.yaml"),
+		"overridden_by": filepath.Join("testdata", "conf.d", "30-team-b.yml"),
+	}, logs.All()[0].ContextMap())
+	assert.NoError(t, dp.Shutdown(context.Background()))
+}
+
+func TestRetrieveOrigins(t *testing.T) {
+	dir := filepath.Join("testdata", "conf.d")
+	resolver, err := confmap.NewResolver(confmap.ResolverSettings{
+		URIs:              []string{dirSchemePrefix + dir},
+		ProviderFactories: []confmap.ProviderFactory{NewFactory()},
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+
+	uri := dirSchemePrefix + dir
+	assert.Equal(t, []confmap.Origin{
+		{Key: "exporters::otlp::endpoint", URI: uri, File: filepath.Join(dir, "30-team-b.yml"), Line: 3},
+	}, conf.Origins("exporters::otlp::endpoint"))
+	assert.Equal(t, []confmap.Origin{
+		{Key: "service::pipelines::traces/team-a", URI: uri, File: filepath.Join(dir, "20-team-a.yaml"), Line: 8},
+		{Key: "service::pipelines::traces/team-a::exporters", URI: uri, File: filepath.Join(dir, "20-team-a.yaml"), Line: 10},
+		{Key: "service::pipelines::traces/team-a::receivers", URI: uri, File: filepath.Join(dir, "20-team-a.yaml"), Line: 9},
+	}, conf.Origins("service::pipelines::traces/team-a"))
+	assert.NoError(t, resolver.Shutdown(context.Background()))
+}
+
+func TestRetrieveEmpty(t *testing.T) {
+	dp := createProvider()
+	ret, err := dp.Retrieve(context.Background(), dirSchemePrefix+t.TempDir(), nil)
+	require.NoError(t, err)
+	conf, err := ret.AsConf()
+	require.NoError(t, err)
+	assert.Empty(t, conf.AllKeys())
+	assert.NoError(t, dp.Shutdown(context.Background()))
+}
+
+func TestRetrieveInvalidFile(t *testing.T) {
+	dp := createProvider()
+	_, err := dp.Retrieve(context.Background(), dirSchemePrefix+filepath.Join("testdata", "invalid"), nil)
+	assert.ErrorContains(t, err, "unable to use the file "+filepath.Join("testdata", "invalid", "20-list.yaml")+" as a configuration")
+	assert.NoError(t, dp.Shutdown(context.Background()))
+}
+
+func TestWatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		change func(t *testing.T, dir string)
+	}{
+		{
+			name: "changed file",
+			opts: []Option{WithDebounce(10 * time.Millisecond)},
+			change: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "10-base.yaml"), []byte("key: other"), 0600))
+			},
+		},
+		{
+			name: "added file",
+			opts: []Option{WithDebounce(10 * time.Millisecond)},
+			change: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "20-team.yaml"), []byte("team: value"), 0600))
+			},
+		},
+		{
+			name: "removed file",
+			opts: []Option{WithDebounce(10 * time.Millisecond)},
+			change: func(t *testing.T, dir string) {
+				require.NoError(t, os.Remove(filepath.Join(dir, "10-base.yaml")))
+			},
+		},
+		{
+			name: "polling",
+			opts: []Option{WithPollInterval(10 * time.Millisecond), WithDebounce(20 * time.Millisecond)},
+			change: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "20-team.yaml"), []byte("team: value"), 0600))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "10-base.yaml"), []byte("key: value"), 0600))
+
+			dp := createProvider(tt.opts...)
+			changes := make(chan *confmap.ChangeEvent, 1)
+			ret, err := dp.Retrieve(context.Background(), dirSchemePrefix+dir, func(event *confmap.ChangeEvent) {
+				changes <- event
+			})
+			require.NoError(t, err)
+
+			// The files that are not configuration files are not watched.
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("notes"), 0600))
+			select {
+			case <-changes:
+				t.Fatal("unexpected change event")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			tt.change(t, dir)
+			select {
+			case event := <-changes:
+				assert.NoError(t, event.Error)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the watcher was not called")
+			}
+
+			require.NoError(t, ret.Close(context.Background()))
+			require.NoError(t, dp.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestPollIntervalEnvVar(t *testing.T) {
+	t.Setenv(PollIntervalEnvVar, "30s")
+	assert.Equal(t, 30*time.Second, createProvider().(*provider).pollInterval)
+
+	dp := NewFactory(WithPollInterval(time.Minute)).Create(confmaptest.NewNopProviderSettings())
+	assert.Equal(t, time.Minute, dp.(*provider).pollInterval)
+}
+
+func TestWatchClose(t *testing.T) {
+	dir := t.TempDir()
+	dp := createProvider(WithDebounce(10 * time.Millisecond))
+	ret, err := dp.Retrieve(context.Background(), dirSchemePrefix+dir, func(*confmap.ChangeEvent) {
+		t.Error("the watcher must not be called once closed")
+	})
+	require.NoError(t, err)
+	require.NoError(t, ret.Close(context.Background()))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("key: value"), 0600))
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, dp.Shutdown(context.Background()))
+}
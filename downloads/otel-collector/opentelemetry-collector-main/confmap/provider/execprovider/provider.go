@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execprovider // import "go.opentelemetry.io/collector/confmap/provider/execprovider"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+const (
+	schemeName = "exec"
+
+	// AllowedCommandsEnvVar is the environment variable listing the commands allowed to be run,
+	// separated by the OS path list separator, when WithAllowedCommands is not used.
+	AllowedCommandsEnvVar = "OTELCOL_EXEC_PROVIDER_ALLOWED_COMMANDS"
+
+	defaultTimeout = 10 * time.Second
+	// waitDelay bounds the time waiting for the output of a killed command,
+	// in case it started processes that keep the output open.
+	waitDelay = time.Second
+)
+
+type provider struct {
+	logger  *zap.Logger
+	allowed map[string]struct{}
+	// allowedSource describes where the allowed commands come from, for the error messages.
+	allowedSource string
+
+	timeout         time.Duration
+	refreshInterval time.Duration
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// Option configures the exec provider.
+type Option func(*provider)
+
+// WithAllowedCommands sets the absolute paths of the commands that the provider is allowed to run.
+// It overrides the commands listed by the AllowedCommandsEnvVar environment variable.
+func WithAllowedCommands(paths ...string) Option {
+	return func(p *provider) {
+		p.allowed = allowedCommands(paths)
+		p.allowedSource = "the commands set with WithAllowedCommands"
+	}
+}
+
+// WithTimeout sets how long a command can run before it is killed. The default is 10s.
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *provider) {
+		p.timeout = timeout
+	}
+}
+
+// WithRefreshInterval makes the provider run the retrieved commands again every interval,
+// and call the watcher once the output of a command changes, so the configuration is reloaded
+// with the new value. By default, the commands are not run again.
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(p *provider) {
+		p.refreshInterval = interval
+	}
+}
+
+// NewFactory returns a factory for a confmap.Provider that runs a local command and uses its output as the value.
+//
+// This Provider supports "exec" scheme, and can be called with a "uri" that follows:
+//
+//	exec-uri	= "exec:" command-path *( " " argument )
+//
+// The command is run directly, without a shell, with the arguments split on the whitespace.
+// Its path must be absolute and allowed by WithAllowedCommands, or by the AllowedCommandsEnvVar environment variable.
+//
+// Examples:
+// `exec:/usr/bin/get-secret db-password`
+// `exec:/usr/bin/pass show collector/api-key`
+//
+// The output of the command, without its trailing line breaks, is returned as a string and is never parsed as YAML,
+// so it is suited for the `configopaque.String` fields keeping the secrets out of the logs. The outputs are cached
+// by URI until the provider is shut down, or until the refresh sees a new output, see WithRefreshInterval.
+func NewFactory(opts ...Option) confmap.ProviderFactory {
+	return confmap.NewProviderFactory(func(set confmap.ProviderSettings) confmap.Provider {
+		return newProvider(set, opts...)
+	})
+}
+
+func newProvider(set confmap.ProviderSettings, opts ...Option) confmap.Provider {
+	p := &provider{
+		logger:        set.Logger,
+		allowed:       allowedCommands(filepath.SplitList(os.Getenv(AllowedCommandsEnvVar))),
+		allowedSource: "the " + AllowedCommandsEnvVar + " environment variable",
+		timeout:       defaultTimeout,
+		cache:         make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func allowedCommands(paths []string) map[string]struct{} {
+	allowed := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		if path = strings.TrimSpace(path); path != "" {
+			allowed[filepath.Clean(path)] = struct{}{}
+		}
+	}
+	return allowed
+}
+
+func (emp *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+	args := strings.Fields(uri[len(schemeName)+1:])
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%q uri does not contain a command", uri)
+	}
+	if !filepath.IsAbs(args[0]) {
+		return nil, fmt.Errorf("command %q must be an absolute path", args[0])
+	}
+	if _, ok := emp.allowed[filepath.Clean(args[0])]; !ok {
+		return nil, fmt.Errorf("command %q is not allowed, it must be listed in %s", args[0], emp.allowedSource)
+	}
+
+	value, err := emp.cachedOutput(ctx, uri, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []confmap.RetrievedOption
+	if watcher != nil && emp.refreshInterval > 0 {
+		r := emp.refresh(uri, args, value, watcher)
+		opts = append(opts, confmap.WithRetrievedClose(r.close))
+	}
+	return confmap.NewRetrieved(value, opts...)
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (emp *provider) Shutdown(context.Context) error {
+	emp.mu.Lock()
+	defer emp.mu.Unlock()
+	clear(emp.cache)
+	return nil
+}
+
+func (emp *provider) cachedOutput(ctx context.Context, uri string, args []string) (string, error) {
+	emp.mu.Lock()
+	value, ok := emp.cache[uri]
+	emp.mu.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := emp.run(ctx, args)
+	if err != nil {
+		return "", err
+	}
+	emp.mu.Lock()
+	emp.cache[uri] = value
+	emp.mu.Unlock()
+	return value, nil
+}
+
+// run runs the command and returns its output. The output is never logged, since it is usually a secret.
+func (emp *provider) run(ctx context.Context, args []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, emp.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("command %q timed out after %v", args[0], emp.timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("command %q failed: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("command %q failed: %w", args[0], err)
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+// writeScript writes a shell script running the given commands, and returns its path.
+func writeScript(t *testing.T, commands string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test scripts require a POSIX shell")
+	}
+	path := filepath.Join(t.TempDir(), "script.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+commands+"\n"), 0700)) // #nosec G306
+	return path
+}
+
+func createProvider(opts ...Option) confmap.Provider {
+	return NewFactory(opts...).Create(confmaptest.NewNopProviderSettings())
+}
+
+func TestValidateProviderScheme(t *testing.T) {
+	assert.NoError(t, confmaptest.ValidateProviderScheme(createProvider()))
+}
+
+func TestUnsupportedScheme(t *testing.T) {
+	ep := createProvider()
+	_, err := ep.Retrieve(context.Background(), "https://", nil)
+	assert.Error(t, err)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestInvalidCommand(t *testing.T) {
+	script := writeScript(t, "echo secret")
+	tests := []struct {
+		name        string
+		uri         string
+		expectedErr string
+	}{
+		{
+			name:        "empty",
+			uri:         "exec: ",
+			expectedErr: `"exec: " uri does not contain a command`,
+		},
+		{
+			name:        "relative path",
+			uri:         "exec:script.sh",
+			expectedErr: `command "script.sh" must be an absolute path`,
+		},
+		{
+			name:        "not allowed",
+			uri:         "exec:/bin/cat /etc/passwd",
+			expectedErr: `command "/bin/cat" is not allowed, it must be listed in the commands set with WithAllowedCommands`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep := createProvider(WithAllowedCommands(script))
+			_, err := ep.Retrieve(context.Background(), tt.uri, nil)
+			assert.EqualError(t, err, tt.expectedErr)
+			assert.NoError(t, ep.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestRetrieve(t *testing.T) {
+	script := writeScript(t, `printf '%s-%s\n\n' "$1" "$2"`)
+	ep := createProvider(WithAllowedCommands(script))
+	ret, err := ep.Retrieve(context.Background(), "exec:"+script+"  db password", nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "db-password", raw)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestRetrieveNotParsedAsYAML(t *testing.T) {
+	script := writeScript(t, "echo '123'")
+	ep := createProvider(WithAllowedCommands(script))
+	ret, err := ep.Retrieve(context.Background(), "exec:"+script, nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "123", raw)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestAllowedCommandsEnvVar(t *testing.T) {
+	script := writeScript(t, "echo secret")
+	t.Setenv(AllowedCommandsEnvVar, "/usr/bin/other"+string(os.PathListSeparator)+script)
+	ep := createProvider()
+	ret, err := ep.Retrieve(context.Background(), "exec:"+script, nil)
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "secret", raw)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestAllowedCommandsEnvVarNotAllowed(t *testing.T) {
+	script := writeScript(t, "echo secret")
+	t.Setenv(AllowedCommandsEnvVar, script)
+	ep := createProvider()
+	_, err := ep.Retrieve(context.Background(), "exec:/bin/cat /etc/passwd", nil)
+	assert.EqualError(t, err, `command "/bin/cat" is not allowed, it must be listed in the OTELCOL_EXEC_PROVIDER_ALLOWED_COMMANDS environment variable`)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestCommandFailure(t *testing.T) {
+	script := writeScript(t, "echo 'secret not found' >&2\nexit 3")
+	ep := createProvider(WithAllowedCommands(script))
+	_, err := ep.Retrieve(context.Background(), "exec:"+script, nil)
+	assert.EqualError(t, err, `command "`+script+`" failed: exit status 3: secret not found`)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestCommandTimeout(t *testing.T) {
+	script := writeScript(t, "sleep 10")
+	ep := createProvider(WithAllowedCommands(script), WithTimeout(50*time.Millisecond))
+	_, err := ep.Retrieve(context.Background(), "exec:"+script, nil)
+	assert.EqualError(t, err, `command "`+script+`" timed out after 50ms`)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestCache(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "counter")
+	script := writeScript(t, "echo x >> "+counter+"\nwc -l < "+counter)
+	ep := createProvider(WithAllowedCommands(script))
+	retrieve := func() any {
+		ret, err := ep.Retrieve(context.Background(), "exec:"+script, nil)
+		require.NoError(t, err)
+		raw, err := ret.AsRaw()
+		require.NoError(t, err)
+		return raw
+	}
+	first := retrieve()
+	assert.Equal(t, first, retrieve())
+
+	// The cache is cleared on shutdown.
+	require.NoError(t, ep.Shutdown(context.Background()))
+	assert.NotEqual(t, first, retrieve())
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestRefresh(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("old"), 0600))
+	script := writeScript(t, "cat "+secret)
+	ep := createProvider(WithAllowedCommands(script), WithRefreshInterval(10*time.Millisecond))
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	ret, err := ep.Retrieve(context.Background(), "exec:"+script, func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "old", raw)
+
+	require.NoError(t, os.WriteFile(secret, []byte("new"), 0600))
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watcher was not called")
+	}
+	require.NoError(t, ret.Close(context.Background()))
+
+	// The refreshed value is cached.
+	ret, err = ep.Retrieve(context.Background(), "exec:"+script, nil)
+	require.NoError(t, err)
+	raw, err = ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "new", raw)
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
+
+func TestRefreshClose(t *testing.T) {
+	script := writeScript(t, "echo secret")
+	ep := createProvider(WithAllowedCommands(script), WithRefreshInterval(10*time.Millisecond))
+	ret, err := ep.Retrieve(context.Background(), "exec:"+script, func(*confmap.ChangeEvent) {
+		t.Error("the watcher must not be called")
+	})
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, ret.Close(context.Background()))
+	assert.NoError(t, ep.Shutdown(context.Background()))
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execprovider // import "go.opentelemetry.io/collector/confmap/provider/execprovider"
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// refresher runs a command every refresh interval, and calls the watcher once its output differs from the retrieved one.
+type refresher struct {
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+func (emp *provider) refresh(uri string, args []string, value string, onChange confmap.WatcherFunc) *refresher {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &refresher{
+		cancel: cancel,
+		doneCh: make(chan struct{}),
+	}
+	go emp.runRefresh(ctx, r.doneCh, uri, args, value, onChange)
+	return r
+}
+
+func (emp *provider) runRefresh(ctx context.Context, doneCh chan struct{}, uri string, args []string, value string, onChange confmap.WatcherFunc) {
+	defer close(doneCh)
+
+	logger := emp.logger.With(zap.String("command", args[0]))
+	ticker := time.NewTicker(emp.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			out, err := emp.run(ctx, args)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Warn("Unable to refresh the configuration value, keeping the previous one", zap.Error(err))
+				}
+				continue
+			}
+			if out == value {
+				continue
+			}
+			emp.mu.Lock()
+			emp.cache[uri] = out
+			emp.mu.Unlock()
+			logger.Info("Command output changed")
+			onChange(&confmap.ChangeEvent{})
+			return
+		}
+	}
+}
+
+func (r *refresher) close(context.Context) error {
+	r.cancel()
+	<-r.doneCh
+	return nil
+}
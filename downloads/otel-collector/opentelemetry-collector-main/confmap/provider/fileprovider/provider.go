@@ -5,17 +5,56 @@ package fileprovider // import "go.opentelemetry.io/collector/confmap/provider/f
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/internal/watcher"
+)
+
+const (
+	schemeName = "file"
+
+	// PollIntervalEnvVar is the environment variable setting the interval at which the retrieved files are polled
+	// for changes, like "30s", when WithPollInterval is not used.
+	PollIntervalEnvVar = "OTELCOL_FILE_PROVIDER_POLL_INTERVAL"
+
+	defaultDebounce = 500 * time.Millisecond
 )
 
-const schemeName = "file"
+type provider struct {
+	logger       *zap.Logger
+	pollInterval time.Duration
+	debounce     time.Duration
+}
+
+// Option configures the file provider.
+type Option func(*provider)
+
+// WithPollInterval makes the provider poll the retrieved files for changes every interval,
+// instead of relying on the file system notifications. Polling can be needed on file systems
+// that don't support notifications, like some network file systems.
+// It overrides the interval set by the PollIntervalEnvVar environment variable.
+func WithPollInterval(interval time.Duration) Option {
+	return func(p *provider) {
+		p.pollInterval = interval
+	}
+}
 
-type provider struct{}
+// WithDebounce sets how long the content of a retrieved file must be left unchanged
+// before the watcher is called, so the writes done in several steps result in a single reload.
+// The default is 500ms.
+func WithDebounce(debounce time.Duration) Option {
+	return func(p *provider) {
+		p.debounce = debounce
+	}
+}
 
 // NewFactory returns a factory for a confmap.Provider that reads the configuration from a file.
 //
@@ -32,26 +71,70 @@ type provider struct{}
 // `file:/path/to/file` - absolute path (unix, windows)
 // `file:c:/path/to/file` - absolute path including drive-letter (windows)
 // `file:c:\path\to\file` - absolute path including drive-letter (windows)
-func NewFactory() confmap.ProviderFactory {
-	return confmap.NewProviderFactory(newProvider)
+//
+// When Retrieve is given a watcher, the file is watched until the returned confmap.Retrieved is closed,
+// and the watcher is called once the content of the file changes. The directory of the file is watched,
+// so the files replaced by renaming or by updating a symbolic link, like the Kubernetes ConfigMap volumes, are
+// supported too. The files are polled instead at the interval set by WithPollInterval, or by the
+// PollIntervalEnvVar environment variable.
+func NewFactory(opts ...Option) confmap.ProviderFactory {
+	return confmap.NewProviderFactory(func(set confmap.ProviderSettings) confmap.Provider {
+		return newProvider(set, opts...)
+	})
 }
 
-func newProvider(confmap.ProviderSettings) confmap.Provider {
-	return &provider{}
+func newProvider(set confmap.ProviderSettings, opts ...Option) confmap.Provider {
+	p := &provider{
+		logger:   set.Logger,
+		debounce: defaultDebounce,
+	}
+	p.pollInterval = watcher.PollIntervalFromEnv(p.logger, PollIntervalEnvVar)
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
-func (fmp *provider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+func (fmp *provider) Retrieve(_ context.Context, uri string, onChange confmap.WatcherFunc) (*confmap.Retrieved, error) {
 	if !strings.HasPrefix(uri, schemeName+":") {
 		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
 	}
 
 	// Clean the path before using it.
-	content, err := os.ReadFile(filepath.Clean(uri[len(schemeName)+1:]))
+	path := filepath.Clean(uri[len(schemeName)+1:])
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read the file %v: %w", uri, err)
 	}
 
-	return confmap.NewRetrievedFromYAML(content)
+	if onChange == nil {
+		return confmap.NewRetrievedFromYAML(content)
+	}
+
+	// The directory of the file is watched, so the notifications are not lost when the file is replaced.
+	fw := watcher.Watch(watcher.WatchSettings{
+		Name:         "file",
+		Dir:          filepath.Dir(path),
+		Hash:         sha256.Sum256(content),
+		ReadHash:     func() ([sha256.Size]byte, error) { return readHash(path) },
+		Logger:       fmp.logger.With(zap.String("path", path)),
+		PollInterval: fmp.pollInterval,
+		Debounce:     fmp.debounce,
+	}, onChange)
+	ret, err := confmap.NewRetrievedFromYAML(content, confmap.WithRetrievedClose(fw.Close))
+	if err != nil {
+		_ = fw.Close(context.Background())
+		return nil, err
+	}
+	return ret, nil
+}
+
+func readHash(path string) ([sha256.Size]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(content), nil
 }
 
 func (*provider) Scheme() string {
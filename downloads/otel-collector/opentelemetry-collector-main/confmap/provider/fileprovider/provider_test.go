@@ -7,7 +7,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,3 +101,108 @@ func absolutePath(t *testing.T, relativePath string) string {
 func createProvider() confmap.Provider {
 	return NewFactory().Create(confmaptest.NewNopProviderSettings())
 }
+
+func TestWatch(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "notifications", opts: []Option{WithDebounce(10 * time.Millisecond)}},
+		{name: "polling", opts: []Option{WithPollInterval(10 * time.Millisecond), WithDebounce(20 * time.Millisecond)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte("key: value"), 0600))
+
+			fp := NewFactory(tt.opts...).Create(confmaptest.NewNopProviderSettings())
+			changes := make(chan *confmap.ChangeEvent, 1)
+			ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+path, func(event *confmap.ChangeEvent) {
+				changes <- event
+			})
+			require.NoError(t, err)
+
+			// Writing the same content is not a change.
+			require.NoError(t, os.WriteFile(path, []byte("key: value"), 0600))
+			select {
+			case <-changes:
+				t.Fatal("unexpected change event")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			require.NoError(t, os.WriteFile(path, []byte("key: other"), 0600))
+			select {
+			case event := <-changes:
+				assert.NoError(t, event.Error)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the watcher was not called")
+			}
+
+			require.NoError(t, ret.Close(context.Background()))
+			require.NoError(t, fp.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestPollIntervalEnvVar(t *testing.T) {
+	t.Setenv(PollIntervalEnvVar, "30s")
+	assert.Equal(t, 30*time.Second, createProvider().(*provider).pollInterval)
+
+	fp := NewFactory(WithPollInterval(time.Minute)).Create(confmaptest.NewNopProviderSettings())
+	assert.Equal(t, time.Minute, fp.(*provider).pollInterval)
+
+	t.Setenv(PollIntervalEnvVar, "often")
+	assert.Zero(t, createProvider().(*provider).pollInterval)
+}
+
+func TestWatchSymlinkSwap(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("creating symbolic links requires privileges on windows")
+	}
+	// Reproduces the way Kubernetes updates the files of the ConfigMap volumes.
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "v1"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v1", "config.yaml"), []byte("key: v1"), 0600))
+	require.NoError(t, os.Symlink("v1", filepath.Join(dir, "..data")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "config.yaml"), filepath.Join(dir, "config.yaml")))
+
+	fp := NewFactory(WithDebounce(10 * time.Millisecond)).Create(confmaptest.NewNopProviderSettings())
+	changes := make(chan *confmap.ChangeEvent, 1)
+	ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+filepath.Join(dir, "config.yaml"), func(event *confmap.ChangeEvent) {
+		changes <- event
+	})
+	require.NoError(t, err)
+	raw, err := ret.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"key": "v1"}, raw)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "v2"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v2", "config.yaml"), []byte("key: v2"), 0600))
+	require.NoError(t, os.Symlink("v2", filepath.Join(dir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+
+	select {
+	case event := <-changes:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watcher was not called")
+	}
+	require.NoError(t, ret.Close(context.Background()))
+	require.NoError(t, fp.Shutdown(context.Background()))
+}
+
+func TestWatchClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("key: value"), 0600))
+
+	fp := NewFactory(WithDebounce(10 * time.Millisecond)).Create(confmaptest.NewNopProviderSettings())
+	ret, err := fp.Retrieve(context.Background(), fileSchemePrefix+path, func(*confmap.ChangeEvent) {
+		t.Error("the watcher must not be called once closed")
+	})
+	require.NoError(t, err)
+	require.NoError(t, ret.Close(context.Background()))
+
+	require.NoError(t, os.WriteFile(path, []byte("key: other"), 0600))
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, fp.Shutdown(context.Background()))
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watcher watches the configuration retrieved by the file and directory providers.
+package watcher // import "go.opentelemetry.io/collector/confmap/provider/internal/watcher"
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// fallbackPollInterval is used when the file system notifications are not available.
+const fallbackPollInterval = 5 * time.Second
+
+// WatchSettings configures a Watcher.
+type WatchSettings struct {
+	// Name names what is watched in the logs, like "file" or "directory".
+	Name string
+	// Dir is the directory watched with the file system notifications.
+	Dir string
+	// Hash is the hash of the retrieved content.
+	Hash [sha256.Size]byte
+	// ReadHash returns the hash of the current content.
+	ReadHash func() ([sha256.Size]byte, error)
+	// Logger logs the errors and the changes.
+	Logger *zap.Logger
+	// PollInterval makes the watcher poll the content every interval instead of relying on the notifications,
+	// if greater than zero.
+	PollInterval time.Duration
+	// Debounce is how long the content must be left unchanged before the watcher is called.
+	Debounce time.Duration
+}
+
+// Watcher calls a confmap.WatcherFunc once the content differs from the retrieved one.
+type Watcher struct {
+	set      WatchSettings
+	onChange confmap.WatcherFunc
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// Watch starts watching the content until the returned Watcher is closed. The directory is watched rather than
+// the files themselves, so the notifications are not lost when a file is replaced. The notifications only
+// trigger a new hash of the content, and the watcher is called once, when the hash changed.
+func Watch(set WatchSettings, onChange confmap.WatcherFunc) *Watcher {
+	w := &Watcher{
+		set:      set,
+		onChange: onChange,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+
+	pollInterval := set.PollInterval
+	var notifier *fsnotify.Watcher
+	if pollInterval <= 0 {
+		var err error
+		if notifier, err = newNotifier(set.Dir); err != nil {
+			set.Logger.Warn("Unable to watch the configuration "+set.Name+" for changes, polling it instead", zap.Error(err))
+			pollInterval = fallbackPollInterval
+		}
+	}
+
+	go w.run(notifier, pollInterval)
+	return w
+}
+
+// PollIntervalFromEnv returns the poll interval set by the given environment variable, like "30s",
+// or zero if it is unset or invalid.
+func PollIntervalFromEnv(logger *zap.Logger, envVar string) time.Duration {
+	env := os.Getenv(envVar)
+	if env == "" {
+		return 0
+	}
+	interval, err := time.ParseDuration(env)
+	if err != nil {
+		logger.Warn("Invalid poll interval, the configuration is watched with the file system notifications",
+			zap.String("env", envVar), zap.Error(err))
+		return 0
+	}
+	return interval
+}
+
+func newNotifier(dir string) (*fsnotify.Watcher, error) {
+	notifier, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err = notifier.Add(dir); err != nil {
+		_ = notifier.Close()
+		return nil, err
+	}
+	return notifier, nil
+}
+
+func (w *Watcher) run(notifier *fsnotify.Watcher, pollInterval time.Duration) {
+	defer close(w.doneCh)
+
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	var ticks <-chan time.Time
+	if notifier != nil {
+		defer notifier.Close()
+		events, errs = notifier.Events, notifier.Errors
+	} else {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	debounceTimer := time.NewTimer(w.set.Debounce)
+	defer debounceTimer.Stop()
+	if !debounceTimer.Stop() {
+		<-debounceTimer.C
+	}
+	last := w.set.Hash
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+			debounceTimer.Reset(w.set.Debounce)
+		case err, ok := <-errs:
+			if !ok {
+				return
+			}
+			w.set.Logger.Warn("Error watching the configuration "+w.set.Name+" for changes", zap.Error(err))
+		case <-ticks:
+			hash, err := w.set.ReadHash()
+			if err != nil || hash == last {
+				continue
+			}
+			last = hash
+			debounceTimer.Reset(w.set.Debounce)
+		case <-debounceTimer.C:
+			hash, err := w.set.ReadHash()
+			if err != nil {
+				// A file may be in the middle of being replaced, wait for the next change.
+				w.set.Logger.Debug("Unable to read the configuration "+w.set.Name, zap.Error(err))
+				continue
+			}
+			if hash == w.set.Hash {
+				continue
+			}
+			w.set.Logger.Info("Configuration " + w.set.Name + " changed")
+			w.onChange(&confmap.ChangeEvent{})
+			return
+		}
+	}
+}
+
+// Close stops watching the content. It is used as the close function of the confmap.Retrieved.
+func (w *Watcher) Close(context.Context) error {
+	w.stopOnce.Do(func() { close(w.stopCh) })
+	<-w.doneCh
+	return nil
+}
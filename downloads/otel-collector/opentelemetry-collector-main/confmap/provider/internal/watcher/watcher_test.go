@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+func TestWatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		pollInterval time.Duration
+	}{
+		{name: "notifications"},
+		{name: "polling", pollInterval: 10 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte("key: value"), 0600))
+			readHash := func() ([sha256.Size]byte, error) {
+				content, err := os.ReadFile(path)
+				return sha256.Sum256(content), err
+			}
+			hash, err := readHash()
+			require.NoError(t, err)
+
+			changes := make(chan *confmap.ChangeEvent, 1)
+			w := Watch(WatchSettings{
+				Name:         "file",
+				Dir:          filepath.Dir(path),
+				Hash:         hash,
+				ReadHash:     readHash,
+				Logger:       zap.NewNop(),
+				PollInterval: tt.pollInterval,
+				Debounce:     20 * time.Millisecond,
+			}, func(event *confmap.ChangeEvent) {
+				changes <- event
+			})
+
+			// Writing the same content is not a change.
+			require.NoError(t, os.WriteFile(path, []byte("key: value"), 0600))
+			select {
+			case <-changes:
+				t.Fatal("unexpected change event")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			require.NoError(t, os.WriteFile(path, []byte("key: other"), 0600))
+			select {
+			case event := <-changes:
+				assert.NoError(t, event.Error)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the watcher was not called")
+			}
+			require.NoError(t, w.Close(context.Background()))
+		})
+	}
+}
+
+func TestWatchMissingDir(t *testing.T) {
+	// The watcher falls back to polling when the directory can't be watched.
+	w := Watch(WatchSettings{
+		Name:     "directory",
+		Dir:      filepath.Join(t.TempDir(), "missing"),
+		ReadHash: func() ([sha256.Size]byte, error) { return [sha256.Size]byte{}, nil },
+		Logger:   zap.NewNop(),
+	}, func(*confmap.ChangeEvent) {
+		t.Error("the watcher must not be called")
+	})
+	require.NoError(t, w.Close(context.Background()))
+	// Closing twice is a no-op.
+	require.NoError(t, w.Close(context.Background()))
+}
+
+func TestPollIntervalFromEnv(t *testing.T) {
+	const envVar = "OTELCOL_TEST_POLL_INTERVAL"
+	assert.Zero(t, PollIntervalFromEnv(zap.NewNop(), envVar))
+
+	t.Setenv(envVar, "30s")
+	assert.Equal(t, 30*time.Second, PollIntervalFromEnv(zap.NewNop(), envVar))
+
+	t.Setenv(envVar, "often")
+	assert.Zero(t, PollIntervalFromEnv(zap.NewNop(), envVar))
+}
@@ -24,9 +24,14 @@ type Resolver struct {
 	providers     map[string]Provider
 	defaultScheme string
 	converters    []Converter
-
-	closers []CloseFunc
-	watcher chan error
+	mergeMode     MergeMode
+
+	// templates holds the templates of the configuration being resolved, by name.
+	templates map[string]any
+	// expansions holds the ${...} references expanded for the key being resolved.
+	expansions []string
+	closers    []CloseFunc
+	watcher    chan error
 }
 
 // ResolverSettings are the settings to configure the behavior of the Resolver.
@@ -55,6 +60,10 @@ type ResolverSettings struct {
 	// ConverterSettings contains settings that will be passed to Converter
 	// factories when instantiating Converters.
 	ConverterSettings ConverterSettings
+
+	// MergeMode defines how the lists of the configurations retrieved from the URIs are merged.
+	// If no MergeMode is set, the lists are replaced, see MergeModeReplace.
+	MergeMode MergeMode
 }
 
 // NewResolver returns a new Resolver that resolves configuration from multiple URIs.
@@ -101,6 +110,9 @@ func NewResolver(set ResolverSettings) (*Resolver, error) {
 		if !regexp.MustCompile(schemePattern).MatchString(scheme) {
 			return nil, fmt.Errorf("invalid 'confmap.Provider' scheme %q", scheme)
 		}
+		if scheme == templateScheme {
+			return nil, fmt.Errorf("'confmap.Provider' scheme %q is reserved for the templates", scheme)
+		}
 		// Check that the scheme is unique.
 		if _, ok := providers[scheme]; ok {
 			return nil, fmt.Errorf("duplicate 'confmap.Provider' scheme %q", scheme)
@@ -116,6 +128,12 @@ func NewResolver(set ResolverSettings) (*Resolver, error) {
 		}
 	}
 
+	switch set.MergeMode {
+	case "", MergeModeReplace, MergeModeAppend, MergeModeAppendUnique:
+	default:
+		return nil, fmt.Errorf("invalid 'confmap.ResolverSettings' configuration: unknown MergeMode %q", set.MergeMode)
+	}
+
 	converters := make([]Converter, len(set.ConverterFactories))
 	for i, factory := range set.ConverterFactories {
 		converters[i] = factory.Create(set.ConverterSettings)
@@ -146,6 +164,7 @@ func NewResolver(set ResolverSettings) (*Resolver, error) {
 		providers:     providers,
 		defaultScheme: set.DefaultScheme,
 		converters:    converters,
+		mergeMode:     set.MergeMode,
 		watcher:       make(chan error, 1),
 	}, nil
 }
@@ -160,6 +179,7 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 
 	// Retrieves individual configurations from all URIs in the given order, and merge them in retMap.
 	retMap := New()
+	origins := make(map[string][]Origin)
 	for _, uri := range mr.uris {
 		ret, err := mr.retrieveValue(ctx, uri)
 		if err != nil {
@@ -170,20 +190,37 @@ func (mr *Resolver) Resolve(ctx context.Context) (*Conf, error) {
 		if err != nil {
 			return nil, err
 		}
-		if err = retMap.Merge(retCfgMap); err != nil {
+		if err = retMap.MergeWithMode(retCfgMap, mr.mergeMode); err != nil {
 			return nil, err
 		}
+		mr.recordOrigins(origins, uri.asString(), ret, "", retCfgMap.ToStringMap())
 	}
 
+	// The templates can be defined in any of the configurations, and are referenced after they are merged.
+	templates, err := extractTemplates(retMap)
+	if err != nil {
+		return nil, err
+	}
+	mr.templates = templates
+
 	cfgMap := make(map[string]any)
 	for _, k := range retMap.AllKeys() {
+		if isTemplatesKey(k) {
+			continue
+		}
+		mr.expansions = nil
 		val, err := mr.expandValueRecursively(ctx, retMap.unsanitizedGet(k))
 		if err != nil {
 			return nil, err
 		}
 		cfgMap[k] = escapeDollarSigns(val)
+		if keyOrigins := origins[k]; len(mr.expansions) > 0 && len(keyOrigins) > 0 {
+			keyOrigins[len(keyOrigins)-1].Expansions = mr.expansions
+		}
 	}
+	mr.expansions = nil
 	retMap = NewFromStringMap(cfgMap)
+	retMap.origins = origins
 
 	// Apply the converters in the given order.
 	for _, confConv := range mr.converters {
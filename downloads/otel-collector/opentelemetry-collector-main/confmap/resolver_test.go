@@ -123,6 +123,31 @@ func TestNewResolverDuplicateScheme(t *testing.T) {
 	assert.EqualError(t, err, `duplicate 'confmap.Provider' scheme "mock"`)
 }
 
+func TestNewResolverInvalidMergeMode(t *testing.T) {
+	_, err := NewResolver(ResolverSettings{URIs: []string{"mock:"}, ProviderFactories: []ProviderFactory{newMockProvider(&mockProvider{})}, MergeMode: "prepend"})
+	assert.EqualError(t, err, `invalid 'confmap.ResolverSettings' configuration: unknown MergeMode "prepend"`)
+}
+
+func TestResolverMergeMode(t *testing.T) {
+	resolver, err := NewResolver(ResolverSettings{
+		URIs: []string{"base:", "overlay:"},
+		ProviderFactories: []ProviderFactory{
+			newFakeProvider("base", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+				return NewRetrieved(map[string]any{"exporters": []any{"otlp", "debug"}})
+			}),
+			newFakeProvider("overlay", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+				return NewRetrieved(map[string]any{"exporters": []any{"debug", "otlp/team"}})
+			}),
+		},
+		MergeMode: MergeModeAppendUnique,
+	})
+	require.NoError(t, err)
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"exporters": []any{"otlp", "debug", "otlp/team"}}, conf.ToStringMap())
+	require.NoError(t, resolver.Shutdown(context.Background()))
+}
+
 func TestResolverErrors(t *testing.T) {
 	tests := []struct {
 		name              string
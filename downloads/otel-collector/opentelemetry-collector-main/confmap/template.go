@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap // import "go.opentelemetry.io/collector/confmap"
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// templatesKey is the top-level key of the section holding the named templates of the configuration.
+	// The section is removed from the resolved configuration.
+	templatesKey = "templates"
+	// templateScheme is the scheme of the URIs referencing a template, like ${template:standard_retry}.
+	// It is reserved: no Provider can use it.
+	templateScheme = "template"
+)
+
+// extractTemplates returns the templates defined in the "templates" section of the merged configuration.
+func extractTemplates(conf *Conf) (map[string]any, error) {
+	switch templates := conf.unsanitizedGet(templatesKey).(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return templates, nil
+	default:
+		return nil, fmt.Errorf("the %q section must be a map of named templates, got %T", templatesKey, templates)
+	}
+}
+
+// isTemplatesKey returns whether the flattened key is in the "templates" section.
+func isTemplatesKey(key string) bool {
+	return key == templatesKey || strings.HasPrefix(key, templatesKey+KeyDelimiter)
+}
+
+// expandTemplate returns the value of the template with the given name. The value is expanded like the other values
+// of the configuration, so it can reference environment variables or other templates.
+func (mr *Resolver) expandTemplate(name string) (*Retrieved, error) {
+	value, ok := mr.templates[strings.TrimSpace(name)]
+	if !ok {
+		return nil, fmt.Errorf("template %q is not defined in the %q section", name, templatesKey)
+	}
+	return NewRetrieved(value)
+}
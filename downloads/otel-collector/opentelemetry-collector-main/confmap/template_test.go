@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplatesResolver(t *testing.T, sources map[string]any) *Resolver {
+	inputProvider := newFakeProvider("input", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(sources[uri[len("input:"):]])
+	})
+	envProvider := newFakeProvider("env", func(_ context.Context, uri string, _ WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(map[string]any{"MAX_ELAPSED": "5m"}[uri[len("env:"):]])
+	})
+	uris := make([]string, 0, len(sources))
+	for _, name := range []string{"templates", "config"} {
+		if _, ok := sources[name]; ok {
+			uris = append(uris, "input:"+name)
+		}
+	}
+	resolver, err := NewResolver(ResolverSettings{URIs: uris, ProviderFactories: []ProviderFactory{inputProvider, envProvider}})
+	require.NoError(t, err)
+	return resolver
+}
+
+func TestResolverTemplates(t *testing.T) {
+	resolver := newTemplatesResolver(t, map[string]any{
+		// The templates are defined in another source than the one using them.
+		"templates": map[string]any{
+			"templates": map[string]any{
+				"standard_retry": map[string]any{
+					"enabled":          true,
+					"max_elapsed_time": "${env:MAX_ELAPSED}",
+				},
+				"standard_queue": map[string]any{
+					"queue_size": 1000,
+					"retry":      "${template:standard_retry}",
+				},
+				"region": "eu",
+			},
+		},
+		"config": map[string]any{
+			"exporters": map[string]any{
+				"otlp": map[string]any{
+					"retry_on_failure": "${template:standard_retry}",
+					"sending_queue":    "${template:standard_queue}",
+					"endpoint":         "otlp.${template:region}.example.com",
+				},
+				"otlphttp": map[string]any{
+					"retry_on_failure": "${template: standard_retry }",
+					"escaped":          "$${template:standard_retry}",
+				},
+			},
+		},
+	})
+
+	conf, err := resolver.Resolve(context.Background())
+	require.NoError(t, err)
+	retry := map[string]any{"enabled": true, "max_elapsed_time": "5m"}
+	assert.Equal(t, map[string]any{
+		"exporters": map[string]any{
+			"otlp": map[string]any{
+				"retry_on_failure": retry,
+				"sending_queue":    map[string]any{"queue_size": 1000, "retry": retry},
+				"endpoint":         "otlp.eu.example.com",
+			},
+			"otlphttp": map[string]any{
+				"retry_on_failure": retry,
+				"escaped":          "${template:standard_retry}",
+			},
+		},
+	}, conf.ToStringMap())
+	assert.NoError(t, resolver.Shutdown(context.Background()))
+}
+
+func TestResolverTemplatesErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		sources     map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "undefined template",
+			sources:     map[string]any{"config": map[string]any{"retry": "${template:standard_retry}"}},
+			expectedErr: `template "standard_retry" is not defined in the "templates" section`,
+		},
+		{
+			name:        "invalid templates section",
+			sources:     map[string]any{"config": map[string]any{"templates": []any{"retry"}}},
+			expectedErr: `the "templates" section must be a map of named templates, got []interface {}`,
+		},
+		{
+			name: "recursive template",
+			sources: map[string]any{"config": map[string]any{
+				"templates": map[string]any{"loop": "${template:loop}"},
+				"value":     "${template:loop}",
+			}},
+			expectedErr: errTooManyRecursiveExpansions.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := newTemplatesResolver(t, tt.sources)
+			_, err := resolver.Resolve(context.Background())
+			assert.EqualError(t, err, tt.expectedErr)
+			assert.NoError(t, resolver.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestResolverTemplateSchemeReserved(t *testing.T) {
+	templateProvider := newFakeProvider("template", func(context.Context, string, WatcherFunc) (*Retrieved, error) {
+		return NewRetrieved(nil)
+	})
+	_, err := NewResolver(ResolverSettings{URIs: []string{"template:retry"}, ProviderFactories: []ProviderFactory{templateProvider}})
+	assert.EqualError(t, err, `'confmap.Provider' scheme "template" is reserved for the templates`)
+}
@@ -47,3 +47,12 @@ func TestCreateLogsExporter(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, te)
 }
+
+func TestCreateProfilesExporter(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	te, err := factory.CreateProfilesExporter(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	assert.NotNil(t, te)
+}
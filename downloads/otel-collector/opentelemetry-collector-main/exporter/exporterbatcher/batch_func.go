@@ -14,7 +14,8 @@ type BatchMergeFunc[T any] func(context.Context, T, T) (T, error)
 
 // BatchMergeSplitFunc is a function that merge and/or splits one or two requests into multiple requests based on the
 // configured limit provided in MaxSizeConfig.
-// All the returned requests MUST have a number of items that does not exceed the maximum number of items.
+// All the returned requests MUST have a number of items that does not exceed the maximum number of items,
+// and a size in bytes that does not exceed the maximum size in bytes unless they consist of a single item.
 // Size of the last returned request MUST be less or equal than the size of any other returned request.
 // The original request MUST not be mutated if error is returned after mutation or if the exporter is
 // marked as not mutable. The length of the returned slice MUST not be 0. The optionalReq argument can be nil,
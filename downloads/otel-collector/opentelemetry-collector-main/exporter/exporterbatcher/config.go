@@ -5,11 +5,13 @@ package exporterbatcher // import "go.opentelemetry.io/collector/exporter/export
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
-// Config defines a configuration for batching requests based on a timeout and a minimum number of items.
-// MaxSizeItems defines batch splitting functionality if it's more than zero.
+// Config defines a configuration for batching requests based on a timeout and a minimum number of items or bytes.
+// MaxSizeItems or MaxSizeBytes defines batch splitting functionality if it's more than zero.
 // Experimental: This API is at the early stage of development and may change without backward compatibility
 // until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
 type Config struct {
@@ -21,9 +23,25 @@ type Config struct {
 
 	MinSizeConfig `mapstructure:",squash"`
 	MaxSizeConfig `mapstructure:",squash"`
+
+	// MetadataKeys is a list of client.Metadata keys that will be used to form distinct batches.
+	// If this setting is empty, all the requests are batched together. When this setting is not empty,
+	// the requests are batched separately for every distinct combination of values for the listed metadata keys.
+	//
+	// Empty value and unset metadata are treated as distinct cases.
+	//
+	// Entries are case-insensitive. Duplicated entries will trigger a validation error.
+	// This setting cannot be used with a persistent queue, which does not keep the client metadata of the requests.
+	MetadataKeys []string `mapstructure:"metadata_keys"`
+
+	// MetadataCardinalityLimit indicates the maximum number of distinct combinations of MetadataKeys values
+	// batched separately. The requests with a new combination are rejected once the limit is reached.
+	// The combinations that receive no request for a whole FlushTimeout do not count towards the limit.
+	// Setting this value to zero disables the limit.
+	MetadataCardinalityLimit uint32 `mapstructure:"metadata_cardinality_limit"`
 }
 
-// MinSizeConfig defines the configuration for the minimum number of items in a batch.
+// MinSizeConfig defines the configuration for the minimum size of a batch.
 // Experimental: This API is at the early stage of development and may change without backward compatibility
 // until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
 type MinSizeConfig struct {
@@ -31,9 +49,15 @@ type MinSizeConfig struct {
 	// sent regardless of the timeout. There is no guarantee that the batch size always greater than this value.
 	// This option requires the Request to implement RequestItemsCounter interface. Otherwise, it will be ignored.
 	MinSizeItems int `mapstructure:"min_size_items"`
+
+	// MinSizeBytes is the size in bytes of the batch, once marshaled to OTLP protobuf for OTLP, at which the batch
+	// should be sent regardless of the timeout. If both MinSizeItems and MinSizeBytes are set, the batch is sent
+	// once any of them is reached. This option requires the Request to implement `BytesSize() int`.
+	// Otherwise, it will be ignored.
+	MinSizeBytes int `mapstructure:"min_size_bytes"`
 }
 
-// MaxSizeConfig defines the configuration for the maximum number of items in a batch.
+// MaxSizeConfig defines the configuration for the maximum size of a batch.
 // Experimental: This API is at the early stage of development and may change without backward compatibility
 // until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
 type MaxSizeConfig struct {
@@ -41,6 +65,12 @@ type MaxSizeConfig struct {
 	// If the batch size exceeds this value, it will be broken up into smaller batches if possible.
 	// Setting this value to zero disables the maximum size limit.
 	MaxSizeItems int `mapstructure:"max_size_items"`
+
+	// MaxSizeBytes is the maximum size in bytes of the batch once marshaled to OTLP protobuf for OTLP.
+	// If the batch size exceeds this value, it will be broken up into smaller batches if possible.
+	// A single item bigger than this value is sent in its own batch. It cannot be set together with MaxSizeItems,
+	// and it's not supported by the profiles exporters. Setting this value to zero disables the maximum size limit.
+	MaxSizeBytes int `mapstructure:"max_size_bytes"`
 }
 
 func (c Config) Validate() error {
@@ -53,9 +83,29 @@ func (c Config) Validate() error {
 	if c.MaxSizeItems != 0 && c.MaxSizeItems < c.MinSizeItems {
 		return errors.New("max_size_items must be greater than or equal to min_size_items")
 	}
+	if c.MinSizeBytes < 0 {
+		return errors.New("min_size_bytes must be greater than or equal to zero")
+	}
+	if c.MaxSizeBytes < 0 {
+		return errors.New("max_size_bytes must be greater than or equal to zero")
+	}
+	if c.MaxSizeBytes != 0 && c.MaxSizeBytes < c.MinSizeBytes {
+		return errors.New("max_size_bytes must be greater than or equal to min_size_bytes")
+	}
+	if c.MaxSizeItems != 0 && c.MaxSizeBytes != 0 {
+		return errors.New("max_size_items and max_size_bytes cannot be both set")
+	}
 	if c.FlushTimeout <= 0 {
 		return errors.New("timeout must be greater than zero")
 	}
+	uniq := map[string]bool{}
+	for _, k := range c.MetadataKeys {
+		l := strings.ToLower(k)
+		if _, has := uniq[l]; has {
+			return fmt.Errorf("duplicate entry in metadata_keys: %q (case-insensitive)", l)
+		}
+		uniq[l] = true
+	}
 	return nil
 }
 
@@ -66,5 +116,6 @@ func NewDefaultConfig() Config {
 		MinSizeConfig: MinSizeConfig{
 			MinSizeItems: 8192,
 		},
+		MetadataCardinalityLimit: 1000,
 	}
 }
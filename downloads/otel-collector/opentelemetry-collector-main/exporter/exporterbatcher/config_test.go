@@ -28,4 +28,31 @@ func TestConfig_Validate(t *testing.T) {
 	cfg.MaxSizeItems = 20000
 	cfg.MinSizeItems = 20001
 	assert.EqualError(t, cfg.Validate(), "max_size_items must be greater than or equal to min_size_items")
+
+	cfg = NewDefaultConfig()
+	cfg.MinSizeBytes = -1
+	require.EqualError(t, cfg.Validate(), "min_size_bytes must be greater than or equal to zero")
+
+	cfg = NewDefaultConfig()
+	cfg.MaxSizeBytes = -1
+	require.EqualError(t, cfg.Validate(), "max_size_bytes must be greater than or equal to zero")
+
+	cfg = NewDefaultConfig()
+	cfg.MaxSizeBytes = 1 << 20
+	cfg.MinSizeBytes = 1<<20 + 1
+	assert.EqualError(t, cfg.Validate(), "max_size_bytes must be greater than or equal to min_size_bytes")
+
+	cfg = NewDefaultConfig()
+	cfg.MaxSizeItems = 20000
+	cfg.MaxSizeBytes = 4 << 20
+	assert.EqualError(t, cfg.Validate(), "max_size_items and max_size_bytes cannot be both set")
+
+	cfg = NewDefaultConfig()
+	cfg.MinSizeBytes = 1 << 20
+	cfg.MaxSizeBytes = 4 << 20
+	assert.NoError(t, cfg.Validate())
+
+	cfg = NewDefaultConfig()
+	cfg.MetadataKeys = []string{"X-Scope-OrgID", "x-scope-orgid"}
+	assert.EqualError(t, cfg.Validate(), `duplicate entry in metadata_keys: "x-scope-orgid" (case-insensitive)`)
 }
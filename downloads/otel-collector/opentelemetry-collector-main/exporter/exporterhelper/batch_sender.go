@@ -5,22 +5,41 @@ package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporte
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterbatcher"
 )
 
+var (
+	// errTooManyBatchPartitions is returned when the MetadataCardinalityLimit has been reached.
+	errTooManyBatchPartitions = consumererror.NewPermanent(errors.New("too many batcher metadata-value combinations"))
+
+	// errBatchMetadataKeysPersistentQueue is returned when the MetadataKeys are set with a persistent queue,
+	// which does not keep the client metadata of the requests.
+	errBatchMetadataKeysPersistentQueue = errors.New("batcher metadata_keys cannot be used with a persistent sending queue")
+)
+
 // batchSender is a component that places requests into batches before passing them to the downstream senders.
 // Batches are sent out with any of the following conditions:
-// - batch size reaches cfg.MinSizeItems
+// - batch size reaches cfg.MinSizeItems or cfg.MinSizeBytes
 // - cfg.FlushTimeout is elapsed since the timestamp when the previous batch was sent out.
 // - concurrencyLimit is reached.
+//
+// When cfg.MetadataKeys is set, the requests are batched separately for every distinct combination of values
+// of these keys in the client.Info metadata, each batch partition having its own flush timer.
+// A partition is removed once no request was added to it for a whole flush interval.
 type batchSender struct {
 	baseRequestSender
 	cfg            exporterbatcher.Config
@@ -33,72 +52,227 @@ type batchSender struct {
 	concurrencyLimit int64
 	activeRequests   atomic.Int64
 
-	mu          sync.Mutex
-	activeBatch *batch
-	lastFlushed time.Time
+	// metadataKeys is the lower-cased and sorted list of the configured metadata keys.
+	metadataKeys []string
+
+	// defaultPartition is the only partition used when no metadata keys are configured.
+	defaultPartition *batchPartition
+
+	// mu guards the partitions and their creation after shutdown.
+	mu         sync.Mutex
+	partitions map[attribute.Set]*batchPartition
 
 	logger *zap.Logger
 
-	shutdownCh         chan struct{}
-	shutdownCompleteCh chan struct{}
-	stopped            *atomic.Bool
+	shutdownCh   chan struct{}
+	partitionsWG sync.WaitGroup
+	exportsWG    sync.WaitGroup
+	stopped      *atomic.Bool
 }
 
 // newBatchSender returns a new batch consumer component.
 func newBatchSender(cfg exporterbatcher.Config, set exporter.Settings,
 	mf exporterbatcher.BatchMergeFunc[Request], msf exporterbatcher.BatchMergeSplitFunc[Request]) *batchSender {
+	// use lower-case, to be consistent with http/2 headers.
+	mks := make([]string, len(cfg.MetadataKeys))
+	for i, k := range cfg.MetadataKeys {
+		mks[i] = strings.ToLower(k)
+	}
+	sort.Strings(mks)
 	bs := &batchSender{
-		activeBatch:        newEmptyBatch(),
-		cfg:                cfg,
-		logger:             set.Logger,
-		mergeFunc:          mf,
-		mergeSplitFunc:     msf,
-		shutdownCh:         nil,
-		shutdownCompleteCh: make(chan struct{}),
-		stopped:            &atomic.Bool{},
+		cfg:            cfg,
+		logger:         set.Logger,
+		mergeFunc:      mf,
+		mergeSplitFunc: msf,
+		metadataKeys:   mks,
+		partitions:     map[attribute.Set]*batchPartition{},
+		shutdownCh:     nil,
+		stopped:        &atomic.Bool{},
 	}
+	bs.defaultPartition = newBatchPartition(bs, attribute.NewSet())
 	return bs
 }
 
 func (bs *batchSender) Start(_ context.Context, _ component.Host) error {
 	bs.shutdownCh = make(chan struct{})
-	timer := time.NewTimer(bs.cfg.FlushTimeout)
+	if len(bs.metadataKeys) == 0 {
+		bs.defaultPartition.start()
+	}
+	return nil
+}
+
+// partition returns the batch partition for the metadata of the given context, creating it if needed.
+// It returns nil if the batch sender is stopped. The request is counted as being added to the returned
+// partition until the caller calls batchPartition.added, so the partition is drained on shutdown.
+func (bs *batchSender) partition(ctx context.Context) (*batchPartition, error) {
+	var aset attribute.Set
+	if len(bs.metadataKeys) > 0 {
+		// Get each metadata key value, form the corresponding attribute set for use as a map lookup key.
+		info := client.FromContext(ctx)
+		attrs := make([]attribute.KeyValue, 0, len(bs.metadataKeys))
+		for _, k := range bs.metadataKeys {
+			vs := info.Metadata.Get(k)
+			if len(vs) == 1 {
+				attrs = append(attrs, attribute.String(k, vs[0]))
+			} else {
+				attrs = append(attrs, attribute.StringSlice(k, vs))
+			}
+		}
+		aset = attribute.NewSet(attrs...)
+	}
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if bs.stopped.Load() {
+		return nil, nil
+	}
+	p := bs.defaultPartition
+	if len(bs.metadataKeys) > 0 {
+		var ok bool
+		if p, ok = bs.partitions[aset]; !ok {
+			if bs.cfg.MetadataCardinalityLimit != 0 && len(bs.partitions) >= int(bs.cfg.MetadataCardinalityLimit) {
+				return nil, errTooManyBatchPartitions
+			}
+			p = newBatchPartition(bs, aset)
+			bs.partitions[aset] = p
+			p.start()
+		}
+	}
+	p.mu.Lock()
+	p.adding++
+	p.mu.Unlock()
+	return p, nil
+}
+
+func (bs *batchSender) send(ctx context.Context, req Request) error {
+	// Stopped batch sender should act as pass-through to allow the queue to be drained.
+	if bs.stopped.Load() {
+		return bs.nextSender.send(ctx, req)
+	}
+
+	p, err := bs.partition(ctx)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return bs.nextSender.send(ctx, req)
+	}
+
+	if bs.cfg.MaxSizeItems > 0 || bs.cfg.MaxSizeBytes > 0 {
+		return p.sendMergeSplitBatch(ctx, req)
+	}
+	return p.sendMergeBatch(ctx, req)
+}
+
+func (bs *batchSender) Shutdown(context.Context) error {
+	bs.mu.Lock()
+	bs.stopped.Store(true)
+	bs.mu.Unlock()
+	if bs.shutdownCh != nil {
+		close(bs.shutdownCh)
+		bs.partitionsWG.Wait()
+		bs.exportsWG.Wait()
+	}
+	return nil
+}
+
+// batchPartition holds the active batch of the requests sharing the same metadata values.
+type batchPartition struct {
+	// bs refers to the batch sender, for access to common configuration.
+	bs *batchSender
+	// key is the set of metadata values of the partition in the batch sender.
+	key attribute.Set
+
+	mu          sync.Mutex
+	cond        *sync.Cond
+	activeBatch *batch
+	lastFlushed time.Time
+	// adding is the number of requests that got this partition and are not added to the active batch yet.
+	adding int
+	// used indicates that a request was added since the previous flush timer tick.
+	used bool
+}
+
+func newBatchPartition(bs *batchSender, key attribute.Set) *batchPartition {
+	bp := &batchPartition{
+		bs:          bs,
+		key:         key,
+		activeBatch: newEmptyBatch(),
+	}
+	bp.cond = sync.NewCond(&bp.mu)
+	return bp
+}
+
+// added is called once a request obtained with batchSender.partition is added to the active batch,
+// or is not going to be added to it. Caller must hold the lock.
+func (bp *batchPartition) added() {
+	bp.adding--
+	if bp.adding == 0 {
+		bp.cond.Broadcast()
+	}
+}
+
+// removeIfIdle removes the partition from the batch sender if no request was added to it
+// since the previous flush timer tick. It returns true if the partition was removed.
+func (bp *batchPartition) removeIfIdle() bool {
+	if bp == bp.bs.defaultPartition {
+		return false
+	}
+	bp.bs.mu.Lock()
+	defer bp.bs.mu.Unlock()
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	if bp.bs.stopped.Load() || bp.used || bp.adding > 0 || bp.activeBatch.request != nil {
+		return false
+	}
+	delete(bp.bs.partitions, bp.key)
+	return true
+}
+
+// start starts the goroutine flushing the active batch of the partition once cfg.FlushTimeout is elapsed.
+// The goroutine stops when the batch sender is shut down, or when the partition is removed for being idle.
+func (bp *batchPartition) start() {
+	timer := time.NewTimer(bp.bs.cfg.FlushTimeout)
+	bp.bs.partitionsWG.Add(1)
 	go func() {
+		defer bp.bs.partitionsWG.Done()
 		for {
 			select {
-			case <-bs.shutdownCh:
-				// There is a minimal chance that another request is added after the shutdown signal.
-				// This loop will handle that case.
-				for bs.activeRequests.Load() > 0 {
-					bs.mu.Lock()
-					if bs.activeBatch.request != nil {
-						bs.exportActiveBatch()
-					}
-					bs.mu.Unlock()
+			case <-bp.bs.shutdownCh:
+				// The requests that got the partition before the shutdown signal may not be added yet,
+				// wait for them to be in the active batch before exporting it.
+				bp.mu.Lock()
+				for bp.adding > 0 {
+					bp.cond.Wait()
+				}
+				if bp.activeBatch.request != nil {
+					bp.exportActiveBatch()
 				}
+				bp.mu.Unlock()
 				if !timer.Stop() {
 					<-timer.C
 				}
-				close(bs.shutdownCompleteCh)
 				return
 			case <-timer.C:
-				bs.mu.Lock()
-				nextFlush := bs.cfg.FlushTimeout
-				if bs.activeBatch.request != nil {
-					sinceLastFlush := time.Since(bs.lastFlushed)
-					if sinceLastFlush >= bs.cfg.FlushTimeout {
-						bs.exportActiveBatch()
+				if bp.removeIfIdle() {
+					return
+				}
+				bp.mu.Lock()
+				bp.used = false
+				nextFlush := bp.bs.cfg.FlushTimeout
+				if bp.activeBatch.request != nil {
+					sinceLastFlush := time.Since(bp.lastFlushed)
+					if sinceLastFlush >= bp.bs.cfg.FlushTimeout {
+						bp.exportActiveBatch()
 					} else {
-						nextFlush = bs.cfg.FlushTimeout - sinceLastFlush
+						nextFlush = bp.bs.cfg.FlushTimeout - sinceLastFlush
 					}
 				}
-				bs.mu.Unlock()
+				bp.mu.Unlock()
 				timer.Reset(nextFlush)
 			}
 		}
 	}()
-
-	return nil
 }
 
 type batch struct {
@@ -121,73 +295,82 @@ func newEmptyBatch() *batch {
 
 // exportActiveBatch exports the active batch asynchronously and replaces it with a new one.
 // Caller must hold the lock.
-func (bs *batchSender) exportActiveBatch() {
+func (bp *batchPartition) exportActiveBatch() {
+	bp.bs.exportsWG.Add(1)
 	go func(b *batch) {
-		b.err = bs.nextSender.send(b.ctx, b.request)
+		defer bp.bs.exportsWG.Done()
+		b.err = bp.bs.nextSender.send(b.ctx, b.request)
 		close(b.done)
-		bs.activeRequests.Add(-b.requestsBlocked)
-	}(bs.activeBatch)
-	bs.lastFlushed = time.Now()
-	bs.activeBatch = newEmptyBatch()
+		bp.bs.activeRequests.Add(-b.requestsBlocked)
+	}(bp.activeBatch)
+	bp.lastFlushed = time.Now()
+	bp.activeBatch = newEmptyBatch()
 }
 
 // isActiveBatchReady returns true if the active batch is ready to be exported.
 // The batch is ready if it has reached the minimum size or the concurrency limit is reached.
 // Caller must hold the lock.
-func (bs *batchSender) isActiveBatchReady() bool {
-	return bs.activeBatch.request.ItemsCount() >= bs.cfg.MinSizeItems ||
-		(bs.concurrencyLimit > 0 && bs.activeRequests.Load() >= bs.concurrencyLimit)
+func (bp *batchPartition) isActiveBatchReady() bool {
+	return bp.isActiveBatchMinSizeReached() ||
+		(bp.bs.concurrencyLimit > 0 && bp.bs.activeRequests.Load() >= bp.bs.concurrencyLimit)
 }
 
-func (bs *batchSender) send(ctx context.Context, req Request) error {
-	// Stopped batch sender should act as pass-through to allow the queue to be drained.
-	if bs.stopped.Load() {
-		return bs.nextSender.send(ctx, req)
-	}
-
-	if bs.cfg.MaxSizeItems > 0 {
-		return bs.sendMergeSplitBatch(ctx, req)
+// isActiveBatchMinSizeReached returns true if the active batch has reached any of the configured minimum sizes.
+// Caller must hold the lock.
+func (bp *batchPartition) isActiveBatchMinSizeReached() bool {
+	if bp.bs.cfg.MinSizeBytes > 0 {
+		if req, ok := bp.activeBatch.request.(bytesSizer); ok {
+			if req.BytesSize() >= bp.bs.cfg.MinSizeBytes {
+				return true
+			}
+			if bp.bs.cfg.MinSizeItems == 0 {
+				return false
+			}
+		}
 	}
-	return bs.sendMergeBatch(ctx, req)
+	return bp.activeBatch.request.ItemsCount() >= bp.bs.cfg.MinSizeItems
 }
 
 // sendMergeSplitBatch sends the request to the batch which may be split into multiple requests.
-func (bs *batchSender) sendMergeSplitBatch(ctx context.Context, req Request) error {
-	bs.mu.Lock()
+func (bp *batchPartition) sendMergeSplitBatch(ctx context.Context, req Request) error {
+	bp.mu.Lock()
 
-	reqs, err := bs.mergeSplitFunc(ctx, bs.cfg.MaxSizeConfig, bs.activeBatch.request, req)
+	reqs, err := bp.bs.mergeSplitFunc(ctx, bp.bs.cfg.MaxSizeConfig, bp.activeBatch.request, req)
 	if err != nil || len(reqs) == 0 {
-		bs.mu.Unlock()
+		bp.added()
+		bp.mu.Unlock()
 		return err
 	}
 
-	bs.activeRequests.Add(1)
+	bp.bs.activeRequests.Add(1)
 	if len(reqs) == 1 {
-		bs.activeBatch.requestsBlocked++
+		bp.activeBatch.requestsBlocked++
 	} else {
 		// if there was a split, we want to make sure that bs.activeRequests is released once all of the parts are sent instead of using batch.requestsBlocked
-		defer bs.activeRequests.Add(-1)
+		defer bp.bs.activeRequests.Add(-1)
 	}
-	if len(reqs) == 1 || bs.activeBatch.request != nil {
-		bs.updateActiveBatch(ctx, reqs[0])
-		batch := bs.activeBatch
-		if bs.isActiveBatchReady() || len(reqs) > 1 {
-			bs.exportActiveBatch()
+	if len(reqs) == 1 || bp.activeBatch.request != nil {
+		bp.updateActiveBatch(ctx, reqs[0])
+		batch := bp.activeBatch
+		if bp.isActiveBatchReady() || len(reqs) > 1 {
+			bp.exportActiveBatch()
 		}
-		bs.mu.Unlock()
+		bp.added()
+		bp.mu.Unlock()
 		<-batch.done
 		if batch.err != nil {
 			return batch.err
 		}
 		reqs = reqs[1:]
 	} else {
-		bs.mu.Unlock()
+		bp.added()
+		bp.mu.Unlock()
 	}
 
 	// Intentionally do not put the last request in the active batch to not block it.
 	// TODO: Consider including the partial request in the error to avoid double publishing.
 	for _, r := range reqs {
-		if err := bs.nextSender.send(ctx, r); err != nil {
+		if err := bp.bs.nextSender.send(ctx, r); err != nil {
 			return err
 		}
 	}
@@ -195,26 +378,28 @@ func (bs *batchSender) sendMergeSplitBatch(ctx context.Context, req Request) err
 }
 
 // sendMergeBatch sends the request to the batch and waits for the batch to be exported.
-func (bs *batchSender) sendMergeBatch(ctx context.Context, req Request) error {
-	bs.mu.Lock()
+func (bp *batchPartition) sendMergeBatch(ctx context.Context, req Request) error {
+	bp.mu.Lock()
 
-	if bs.activeBatch.request != nil {
+	if bp.activeBatch.request != nil {
 		var err error
-		req, err = bs.mergeFunc(ctx, bs.activeBatch.request, req)
+		req, err = bp.bs.mergeFunc(ctx, bp.activeBatch.request, req)
 		if err != nil {
-			bs.mu.Unlock()
+			bp.added()
+			bp.mu.Unlock()
 			return err
 		}
 	}
 
-	bs.activeRequests.Add(1)
-	bs.updateActiveBatch(ctx, req)
-	batch := bs.activeBatch
+	bp.bs.activeRequests.Add(1)
+	bp.updateActiveBatch(ctx, req)
+	batch := bp.activeBatch
 	batch.requestsBlocked++
-	if bs.isActiveBatchReady() {
-		bs.exportActiveBatch()
+	if bp.isActiveBatchReady() {
+		bp.exportActiveBatch()
 	}
-	bs.mu.Unlock()
+	bp.added()
+	bp.mu.Unlock()
 	<-batch.done
 	return batch.err
 }
@@ -223,18 +408,11 @@ func (bs *batchSender) sendMergeBatch(ctx context.Context, req Request) error {
 // The context is only set once and is not updated after the first call.
 // Merging the context would be complex and require an additional goroutine to handle the context cancellation.
 // We take the approach of using the context from the first request since it's likely to have the shortest timeout.
-func (bs *batchSender) updateActiveBatch(ctx context.Context, req Request) {
-	if bs.activeBatch.request == nil {
-		bs.activeBatch.ctx = ctx
+// The requests of a partition share the same values of the configured metadata keys.
+func (bp *batchPartition) updateActiveBatch(ctx context.Context, req Request) {
+	if bp.activeBatch.request == nil {
+		bp.activeBatch.ctx = ctx
 	}
-	bs.activeBatch.request = req
-}
-
-func (bs *batchSender) Shutdown(context.Context) error {
-	bs.stopped.Store(true)
-	if bs.shutdownCh != nil {
-		close(bs.shutdownCh)
-		<-bs.shutdownCompleteCh
-	}
-	return nil
+	bp.activeBatch.request = req
+	bp.used = true
 }
@@ -14,9 +14,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exporterbatcher"
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/testdata"
 )
 
 func TestBatchSender_Merge(t *testing.T) {
@@ -703,6 +709,213 @@ func TestBatchSenderTimerFlush(t *testing.T) {
 	require.NoError(t, be.Shutdown(context.Background()))
 }
 
+func TestBatchSender_MinSizeBytes(t *testing.T) {
+	minSize := (&logsRequest{ld: testdata.GenerateLogs(5)}).BytesSize()
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 0
+	cfg.MinSizeBytes = minSize
+	bs := newBatchSender(cfg, exportertest.NewNopSettings(), mergeLogs, mergeSplitLogs)
+
+	bs.defaultPartition.activeBatch.request = &logsRequest{ld: testdata.GenerateLogs(4)}
+	assert.False(t, bs.defaultPartition.isActiveBatchReady())
+	bs.defaultPartition.activeBatch.request = &logsRequest{ld: testdata.GenerateLogs(5)}
+	assert.True(t, bs.defaultPartition.isActiveBatchReady())
+
+	// The batch is ready once any of the minimum sizes is reached.
+	bs.cfg.MinSizeItems = 3
+	bs.defaultPartition.activeBatch.request = &logsRequest{ld: testdata.GenerateLogs(4)}
+	assert.True(t, bs.defaultPartition.isActiveBatchReady())
+
+	// The items are used for the requests which size in bytes is unknown.
+	bs.defaultPartition.activeBatch.request = &fakeRequest{items: 2}
+	assert.False(t, bs.defaultPartition.isActiveBatchReady())
+	bs.defaultPartition.activeBatch.request = &fakeRequest{items: 3}
+	assert.True(t, bs.defaultPartition.isActiveBatchReady())
+}
+
+func TestBatchSender_MaxSizeBytes(t *testing.T) {
+	maxSize := 1000
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 0
+	cfg.MaxSizeBytes = maxSize
+
+	var mu sync.Mutex
+	var batches []plog.Logs
+	push := func(_ context.Context, ld plog.Logs) error {
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, ld)
+		return nil
+	}
+	le, err := NewLogsExporter(context.Background(), exportertest.NewNopSettings(), &fakeLogsExporterConfig, push, WithBatcher(cfg))
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(20)))
+	require.NoError(t, le.Shutdown(context.Background()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Greater(t, len(batches), 1)
+	count := 0
+	for _, ld := range batches {
+		count += ld.LogRecordCount()
+		assert.LessOrEqual(t, logsMarshaler.LogsSize(ld), maxSize)
+	}
+	assert.Equal(t, 20, count)
+}
+
+func newTenantContext(tenant string) context.Context {
+	return client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-scope-orgid": {tenant}}),
+	})
+}
+
+func TestBatchSender_MetadataKeys(t *testing.T) {
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 10
+	cfg.FlushTimeout = time.Minute
+	cfg.MetadataKeys = []string{"X-Scope-OrgID"}
+
+	var mu sync.Mutex
+	exported := map[string][]int{}
+	push := func(ctx context.Context, ld plog.Logs) error {
+		tenant := client.FromContext(ctx).Metadata.Get("x-scope-orgid")[0]
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			v, _ := ld.ResourceLogs().At(i).Resource().Attributes().Get("tenant")
+			assert.Equal(t, tenant, v.Str(), "logs of different tenants must not be batched together")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		exported[tenant] = append(exported[tenant], ld.LogRecordCount())
+		return nil
+	}
+	le, err := NewLogsExporter(context.Background(), exportertest.NewNopSettings(), &fakeLogsExporterConfig, push, WithBatcher(cfg))
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+
+	var wg sync.WaitGroup
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ld := testdata.GenerateLogs(4)
+				ld.ResourceLogs().At(0).Resource().Attributes().PutStr("tenant", tenant)
+				assert.NoError(t, le.ConsumeLogs(newTenantContext(tenant), ld))
+			}()
+		}
+	}
+	wg.Wait()
+	require.NoError(t, le.Shutdown(context.Background()))
+
+	// Each tenant reaches the minimum size on its own, after its third request.
+	assert.Equal(t, map[string][]int{"tenant-a": {12}, "tenant-b": {12}}, exported)
+}
+
+func TestBatchSender_MetadataCardinalityLimit(t *testing.T) {
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 0
+	cfg.MetadataKeys = []string{"X-Scope-OrgID"}
+	cfg.MetadataCardinalityLimit = 2
+	be, err := newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender,
+		WithBatcher(cfg, WithRequestBatchFuncs(fakeBatchMergeFunc, fakeBatchMergeSplitFunc)))
+	require.NoError(t, err)
+	require.NoError(t, be.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, be.Shutdown(context.Background()))
+	})
+
+	sink := newFakeRequestSink()
+	require.NoError(t, be.send(newTenantContext("tenant-a"), &fakeRequest{items: 1, sink: sink}))
+	// Requests without the metadata are batched together.
+	require.NoError(t, be.send(context.Background(), &fakeRequest{items: 1, sink: sink}))
+	require.NoError(t, be.send(newTenantContext("tenant-a"), &fakeRequest{items: 1, sink: sink}))
+
+	err = be.send(newTenantContext("tenant-b"), &fakeRequest{items: 1, sink: sink})
+	require.ErrorIs(t, err, errTooManyBatchPartitions)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Equal(t, uint64(3), sink.requestsCount.Load())
+	assert.Len(t, be.batchSender.(*batchSender).partitions, 2)
+}
+
+func TestBatchSender_MetadataKeysIdlePartition(t *testing.T) {
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 0
+	cfg.FlushTimeout = 10 * time.Millisecond
+	cfg.MetadataKeys = []string{"X-Scope-OrgID"}
+	cfg.MetadataCardinalityLimit = 1
+	be, err := newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender,
+		WithBatcher(cfg, WithRequestBatchFuncs(fakeBatchMergeFunc, fakeBatchMergeSplitFunc)))
+	require.NoError(t, err)
+	require.NoError(t, be.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, be.Shutdown(context.Background()))
+	})
+
+	bs := be.batchSender.(*batchSender)
+	sink := newFakeRequestSink()
+	require.NoError(t, be.send(newTenantContext("tenant-a"), &fakeRequest{items: 1, sink: sink}))
+	require.ErrorIs(t, be.send(newTenantContext("tenant-b"), &fakeRequest{items: 1, sink: sink}), errTooManyBatchPartitions)
+
+	// The partition is removed once no request was added to it for a whole flush interval.
+	assert.Eventually(t, func() bool {
+		bs.mu.Lock()
+		defer bs.mu.Unlock()
+		return len(bs.partitions) == 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, be.send(newTenantContext("tenant-b"), &fakeRequest{items: 1, sink: sink}))
+	assert.Equal(t, uint64(2), sink.requestsCount.Load())
+}
+
+func TestBatchSender_MetadataKeysDrainPartitions(t *testing.T) {
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MinSizeItems = 10
+	cfg.FlushTimeout = time.Minute
+	cfg.MetadataKeys = []string{"X-Scope-OrgID"}
+	be, err := newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender,
+		WithBatcher(cfg, WithRequestBatchFuncs(fakeBatchMergeFunc, fakeBatchMergeSplitFunc)))
+	require.NoError(t, err)
+	require.NoError(t, be.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := newFakeRequestSink()
+	var wg sync.WaitGroup
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, be.send(newTenantContext(tenant), &fakeRequest{items: 1, sink: sink, delay: 20 * time.Millisecond}))
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		return be.batchSender.(*batchSender).activeRequests.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	// Shutdown exports the active batch of every partition and waits for them to be delivered.
+	require.NoError(t, be.Shutdown(context.Background()))
+	assert.Equal(t, uint64(2), sink.requestsCount.Load())
+	assert.Equal(t, uint64(2), sink.itemsCount.Load())
+	wg.Wait()
+}
+
+func TestBatchSender_MetadataKeysPersistentQueue(t *testing.T) {
+	cfg := exporterbatcher.NewDefaultConfig()
+	cfg.MetadataKeys = []string{"X-Scope-OrgID"}
+	storageID := component.MustNewID("file_storage")
+	_, err := newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender,
+		WithBatcher(cfg, WithRequestBatchFuncs(fakeBatchMergeFunc, fakeBatchMergeSplitFunc)),
+		WithRequestQueue(exporterqueue.NewDefaultConfig(), exporterqueue.NewPersistentQueueFactory[Request](&storageID, exporterqueue.PersistentQueueSettings[Request]{
+			Marshaler:   mockRequestMarshaler,
+			Unmarshaler: mockRequestUnmarshaler(&fakeRequest{}),
+		})))
+	require.ErrorIs(t, err, errBatchMetadataKeysPersistentQueue)
+
+	// The requests keep their metadata in a memory queue.
+	_, err = newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender,
+		WithBatcher(cfg, WithRequestBatchFuncs(fakeBatchMergeFunc, fakeBatchMergeSplitFunc)),
+		WithRequestQueue(exporterqueue.NewDefaultConfig(), exporterqueue.NewMemoryQueueFactory[Request]()))
+	require.NoError(t, err)
+}
+
 func queueBatchExporter(t *testing.T, batchOption Option) *baseExporter {
 	be, err := newBaseExporter(defaultSettings, defaultDataType, newNoopObsrepSender, batchOption,
 		WithRequestQueue(exporterqueue.NewDefaultConfig(), exporterqueue.NewMemoryQueueFactory[Request]()))
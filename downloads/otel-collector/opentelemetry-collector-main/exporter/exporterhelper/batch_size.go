@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"math/bits"
+)
+
+// bytesSizer is implemented by the requests which size in bytes can be computed, the same way as for the queue
+// bytes sizer. It's required to honor the min_size_bytes batching option.
+type bytesSizer interface {
+	BytesSize() int
+}
+
+// itemsSizeEncoding is embedded by the sizers counting items: the size of an element is the sum of its children sizes.
+type itemsSizeEncoding struct{}
+
+// deltaSize returns the size that an element of the given size adds to its parent.
+func (itemsSizeEncoding) deltaSize(size int) int {
+	return size
+}
+
+// contentCapacity returns the maximum size of an element which deltaSize is not greater than the given capacity.
+func (itemsSizeEncoding) contentCapacity(capacity int) int {
+	return capacity
+}
+
+// protoSizeEncoding is embedded by the sizers computing the size in bytes of the OTLP protobuf encoding.
+type protoSizeEncoding struct{}
+
+// deltaSize returns the size that an element of the given size adds to its parent:
+// every element is encoded as a field with a one byte tag followed by its varint encoded length.
+func (protoSizeEncoding) deltaSize(size int) int {
+	return 1 + sov(size) + size
+}
+
+// contentCapacity returns the maximum size of an element which deltaSize is not greater than the given capacity.
+func (protoSizeEncoding) contentCapacity(capacity int) int {
+	return capacity - 1 - sov(capacity)
+}
+
+// sov returns the number of bytes of the varint encoding of x.
+func sov(x int) int {
+	if x < 0 {
+		x = 0
+	}
+	return (bits.Len64(uint64(x)|1) + 6) / 7
+}
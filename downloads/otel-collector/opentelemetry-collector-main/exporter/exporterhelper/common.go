@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterbatcher"
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
+	"go.opentelemetry.io/collector/exporter/internal/queue"
 )
 
 // requestSender is an abstraction of a sender for a request independent of the type of the data (traces, metrics, logs).
@@ -106,19 +107,50 @@ func WithQueue(config QueueConfig) Option {
 			o.exportFailureMessage += " Try enabling sending_queue to survive temporary failures."
 			return nil
 		}
-		qf := exporterqueue.NewPersistentQueueFactory[Request](config.StorageID, exporterqueue.PersistentQueueSettings[Request]{
-			Marshaler:   o.marshaler,
-			Unmarshaler: o.unmarshaler,
-		})
-		q := qf(context.Background(), exporterqueue.Settings{
+		set := exporterqueue.Settings{
 			DataType:         o.signal,
 			ExporterSettings: o.set,
-		}, exporterqueue.Config{
-			Enabled:      config.Enabled,
-			NumConsumers: config.NumConsumers,
-			QueueSize:    config.QueueSize,
-		})
-		o.queueSender = newQueueSender(q, o.set, config.NumConsumers, o.exportFailureMessage, o.obsrep)
+		}
+		var q exporterqueue.Queue[Request]
+		if len(config.Priority.Lanes) > 0 {
+			q = newPriorityQueue(config, set, o.marshaler, o.unmarshaler)
+		} else {
+			qf := exporterqueue.NewPersistentQueueFactory[Request](config.StorageID, exporterqueue.PersistentQueueSettings[Request]{
+				Marshaler:   o.marshaler,
+				Unmarshaler: o.unmarshaler,
+			})
+			q = qf(context.Background(), set, exporterqueue.Config{
+				Enabled:      config.Enabled,
+				NumConsumers: config.NumConsumers,
+				Sizer:        config.Sizer,
+				QueueSize:    config.QueueSize,
+			})
+		}
+		var concurrency *concurrencyController
+		if config.AdaptiveConcurrency.Enabled {
+			concurrency = newConcurrencyController(config.AdaptiveConcurrency, config.NumConsumers)
+			o.concurrencySender = newConcurrencySender(concurrency)
+		}
+		o.queueSender = newQueueSender(q, o.set, config.NumConsumers, o.exportFailureMessage, o.obsrep, concurrency)
+		return nil
+	})
+}
+
+// WithDeadLetter enables writing the requests that failed permanently, or ran out of retries,
+// to the storage extension configured in DeadLetterConfig, from where they can be replayed.
+// This option cannot be used with the new exporter helpers New[Traces|Metrics|Logs]RequestExporter.
+func WithDeadLetter(config DeadLetterConfig) Option {
+	return optionFunc(func(o *baseExporter) error {
+		if o.marshaler == nil || o.unmarshaler == nil {
+			return fmt.Errorf("WithDeadLetter option is not available for the new request exporters")
+		}
+		if !config.Enabled {
+			return nil
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		o.deadLetterSender = newDeadLetterSender(config, o.set, o.signal, o.marshaler, o.unmarshaler)
 		return nil
 	})
 }
@@ -253,11 +285,13 @@ type baseExporter struct {
 	// Chain of senders that the exporter helper applies before passing the data to the actual exporter.
 	// The data is handled by each sender in the respective order starting from the queueSender.
 	// Most of the senders are optional, and initialized with a no-op path-through sender.
-	batchSender   requestSender
-	queueSender   requestSender
-	obsrepSender  requestSender
-	retrySender   requestSender
-	timeoutSender *timeoutSender // timeoutSender is always initialized.
+	batchSender       requestSender
+	queueSender       requestSender
+	obsrepSender      requestSender
+	deadLetterSender  requestSender
+	retrySender       requestSender
+	concurrencySender requestSender
+	timeoutSender     *timeoutSender // timeoutSender is always initialized.
 
 	consumerOptions []consumer.Option
 
@@ -276,11 +310,13 @@ func newBaseExporter(set exporter.Settings, signal component.DataType, osf obsre
 	be := &baseExporter{
 		signal: signal,
 
-		batchSender:   &baseRequestSender{},
-		queueSender:   &baseRequestSender{},
-		obsrepSender:  osf(obsReport),
-		retrySender:   &baseRequestSender{},
-		timeoutSender: &timeoutSender{cfg: NewDefaultTimeoutConfig()},
+		batchSender:       &baseRequestSender{},
+		queueSender:       &baseRequestSender{},
+		obsrepSender:      osf(obsReport),
+		deadLetterSender:  &baseRequestSender{},
+		retrySender:       &baseRequestSender{},
+		concurrencySender: &baseRequestSender{},
+		timeoutSender:     &timeoutSender{cfg: NewDefaultTimeoutConfig()},
 
 		set:    set,
 		obsrep: obsReport,
@@ -309,18 +345,30 @@ func newBaseExporter(set exporter.Settings, signal component.DataType, osf obsre
 			DataType:         be.signal,
 			ExporterSettings: be.set,
 		}
-		be.queueSender = newQueueSender(be.queueFactory(context.Background(), set, be.queueCfg), be.set, be.queueCfg.NumConsumers, be.exportFailureMessage, be.obsrep)
+		be.queueSender = newQueueSender(be.queueFactory(context.Background(), set, be.queueCfg), be.set, be.queueCfg.NumConsumers, be.exportFailureMessage, be.obsrep, nil)
 		for _, op := range options {
 			err = multierr.Append(err, op.apply(be))
 		}
 	}
 
+	if bs, ok := be.batchSender.(*batchSender); ok && len(bs.metadataKeys) > 0 {
+		if qs, ok := be.queueSender.(*queueSender); ok && queue.IsPersistent[Request](qs.queue) {
+			err = multierr.Append(err, errBatchMetadataKeysPersistentQueue)
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
 	be.connectSenders()
 
+	if ds, ok := be.deadLetterSender.(*deadLetterSender); ok {
+		// The replayed requests skip the dead-letter storage, but are still recorded by the exporter telemetry.
+		ds.replaySender = osf(obsReport)
+		ds.replaySender.setNextSender(be.retrySender)
+	}
+
 	if bs, ok := be.batchSender.(*batchSender); ok {
 		// If queue sender is enabled assign to the batch sender the same number of workers.
 		if qs, ok := be.queueSender.(*queueSender); ok {
@@ -347,16 +395,26 @@ func (be *baseExporter) send(ctx context.Context, req Request) error {
 func (be *baseExporter) connectSenders() {
 	be.queueSender.setNextSender(be.batchSender)
 	be.batchSender.setNextSender(be.obsrepSender)
-	be.obsrepSender.setNextSender(be.retrySender)
-	be.retrySender.setNextSender(be.timeoutSender)
+	be.obsrepSender.setNextSender(be.deadLetterSender)
+	be.deadLetterSender.setNextSender(be.retrySender)
+	be.retrySender.setNextSender(be.concurrencySender)
+	be.concurrencySender.setNextSender(be.timeoutSender)
 }
 
 func (be *baseExporter) Start(ctx context.Context, host component.Host) error {
+	// The host is used to report the status when the destination keeps rejecting data.
+	be.obsrep.rejectionStatus.setHost(host)
+
 	// First start the wrapped exporter.
 	if err := be.StartFunc.Start(ctx, host); err != nil {
 		return err
 	}
 
+	// Then start the deadLetterSender, so the failed requests can be stored.
+	if err := be.deadLetterSender.Start(ctx, host); err != nil {
+		return err
+	}
+
 	// If no error then start the batchSender.
 	if err := be.batchSender.Start(ctx, host); err != nil {
 		return err
@@ -374,6 +432,17 @@ func (be *baseExporter) Shutdown(ctx context.Context) error {
 		be.batchSender.Shutdown(ctx),
 		// Then shutdown the queue sender.
 		be.queueSender.Shutdown(ctx),
+		// Then shutdown the dead-letter sender, after the requests left in the queue are flushed.
+		be.deadLetterSender.Shutdown(ctx),
 		// Last shutdown the wrapped exporter itself.
 		be.ShutdownFunc.Shutdown(ctx))
 }
+
+// ReplayDeadLetters implements DeadLetterReplayer.
+func (be *baseExporter) ReplayDeadLetters(ctx context.Context) (int, error) {
+	ds, ok := be.deadLetterSender.(*deadLetterSender)
+	if !ok {
+		return 0, errDeadLetterNotEnabled
+	}
+	return ds.replay(ctx)
+}
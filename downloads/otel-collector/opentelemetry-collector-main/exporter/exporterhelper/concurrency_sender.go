@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultConcurrencyDecreaseRatio = 0.5
+
+// AdaptiveConcurrencyConfig defines configuration for adapting the number of queue consumers exporting
+// at the same time. The concurrency is increased by one consumer once as many requests as the current concurrency
+// are exported successfully, and multiplied by DecreaseRatio when the destination throttles the exports
+// or responds slower than LatencyThreshold.
+type AdaptiveConcurrencyConfig struct {
+	// Enabled indicates whether to adapt the concurrency. The NumConsumers of the queue is then used
+	// as the initial and maximum concurrency.
+	Enabled bool `mapstructure:"enabled"`
+	// MinConsumers is the minimum concurrency. Defaults to 1.
+	MinConsumers int `mapstructure:"min_consumers"`
+	// LatencyThreshold if positive, is the export latency above which the concurrency is decreased.
+	LatencyThreshold time.Duration `mapstructure:"latency_threshold"`
+	// DecreaseRatio is the ratio the concurrency is multiplied by when it's decreased. Defaults to 0.5.
+	DecreaseRatio float64 `mapstructure:"decrease_ratio"`
+}
+
+// Validate checks if the AdaptiveConcurrencyConfig configuration is valid
+func (cfg *AdaptiveConcurrencyConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.MinConsumers < 0 {
+		return errors.New("min_consumers must not be negative")
+	}
+	if cfg.LatencyThreshold < 0 {
+		return errors.New("latency_threshold must not be negative")
+	}
+	if cfg.DecreaseRatio < 0 || cfg.DecreaseRatio >= 1 {
+		return errors.New("decrease_ratio must be in the range [0, 1)")
+	}
+	return nil
+}
+
+// concurrencyController limits the number of queue consumers exporting at the same time using
+// an additive increase, multiplicative decrease (AIMD) algorithm.
+type concurrencyController struct {
+	minLimit         int
+	maxLimit         int
+	latencyThreshold time.Duration
+	decreaseRatio    float64
+
+	// mu guards everything declared below.
+	mu     sync.Mutex
+	cond   *sync.Cond
+	limit  int
+	active int
+	// successes is the number of successful exports since the last change of the limit.
+	successes int
+	// epoch is incremented on every decrease, so the exports started before are not decreasing the limit again.
+	epoch   uint64
+	stopped bool
+}
+
+func newConcurrencyController(cfg AdaptiveConcurrencyConfig, maxConsumers int) *concurrencyController {
+	minLimit := cfg.MinConsumers
+	if minLimit < 1 {
+		minLimit = 1
+	}
+	if minLimit > maxConsumers {
+		minLimit = maxConsumers
+	}
+	decreaseRatio := cfg.DecreaseRatio
+	if decreaseRatio == 0 {
+		decreaseRatio = defaultConcurrencyDecreaseRatio
+	}
+	cc := &concurrencyController{
+		minLimit:         minLimit,
+		maxLimit:         maxConsumers,
+		latencyThreshold: cfg.LatencyThreshold,
+		decreaseRatio:    decreaseRatio,
+		limit:            maxConsumers,
+	}
+	cc.cond = sync.NewCond(&cc.mu)
+	return cc
+}
+
+// Acquire implements queue.ConsumersLimiter. It blocks until fewer consumers than the current limit are active.
+func (cc *concurrencyController) Acquire() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for !cc.stopped && cc.active >= cc.limit {
+		cc.cond.Wait()
+	}
+	cc.active++
+}
+
+// Release implements queue.ConsumersLimiter.
+func (cc *concurrencyController) Release() {
+	cc.mu.Lock()
+	cc.active--
+	cc.mu.Unlock()
+	cc.cond.Signal()
+}
+
+// stop lets all the consumers through, so the queue can be drained on shutdown.
+func (cc *concurrencyController) stop() {
+	cc.mu.Lock()
+	cc.stopped = true
+	cc.mu.Unlock()
+	cc.cond.Broadcast()
+}
+
+// concurrency returns the current limit.
+func (cc *concurrencyController) concurrency() int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.limit
+}
+
+func (cc *concurrencyController) currentEpoch() uint64 {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.epoch
+}
+
+// observe updates the limit with the outcome of an export attempt started in the given epoch.
+func (cc *concurrencyController) observe(epoch uint64, latency time.Duration, err error) {
+	congested := isThrottleError(err) || (cc.latencyThreshold > 0 && latency > cc.latencyThreshold)
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if congested {
+		// Only react once to the exports that were in flight when the limit was decreased.
+		if epoch != cc.epoch {
+			return
+		}
+		cc.epoch++
+		cc.successes = 0
+		cc.limit = int(float64(cc.limit) * cc.decreaseRatio)
+		if cc.limit < cc.minLimit {
+			cc.limit = cc.minLimit
+		}
+		return
+	}
+	// Other errors say nothing about the capacity of the destination.
+	if err != nil {
+		return
+	}
+	cc.successes++
+	if cc.successes >= cc.limit && cc.limit < cc.maxLimit {
+		cc.successes = 0
+		cc.limit++
+		cc.cond.Signal()
+	}
+}
+
+// isThrottleError returns true if the error explicitly reports that the destination is overloaded:
+// a throttle error asking to wait before retrying, like a 503 with a Retry-After header,
+// or a RESOURCE_EXHAUSTED status, which is also used by the otlphttp exporter for the 429 responses.
+// Exporters like otlphttp return every retryable response as a throttle error without a delay,
+// so the other retryable errors, like a 502, do not decrease the limit.
+func isThrottleError(err error) bool {
+	if err == nil {
+		return false
+	}
+	throttleErr := throttleRetry{}
+	if errors.As(err, &throttleErr) && throttleErr.delay > 0 {
+		return true
+	}
+	if st, ok := status.FromError(err); ok && st.Code() == codes.ResourceExhausted {
+		return true
+	}
+	return false
+}
+
+// concurrencySender reports the outcome of every export attempt to the concurrencyController.
+type concurrencySender struct {
+	baseRequestSender
+	controller *concurrencyController
+}
+
+func newConcurrencySender(controller *concurrencyController) *concurrencySender {
+	return &concurrencySender{controller: controller}
+}
+
+func (cs *concurrencySender) send(ctx context.Context, req Request) error {
+	epoch := cs.controller.currentEpoch()
+	start := time.Now()
+	err := cs.nextSender.send(ctx, req)
+	cs.controller.observe(epoch, time.Since(start), err)
+	return err
+}
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestAdaptiveConcurrencyConfig_Validate(t *testing.T) {
+	cfg := AdaptiveConcurrencyConfig{MinConsumers: -1}
+	require.NoError(t, cfg.Validate())
+
+	cfg = AdaptiveConcurrencyConfig{Enabled: true, MinConsumers: 2, LatencyThreshold: time.Second, DecreaseRatio: 0.7}
+	require.NoError(t, cfg.Validate())
+
+	cfg.MinConsumers = -1
+	require.EqualError(t, cfg.Validate(), "min_consumers must not be negative")
+
+	cfg.MinConsumers = 1
+	cfg.LatencyThreshold = -time.Second
+	require.EqualError(t, cfg.Validate(), "latency_threshold must not be negative")
+
+	cfg.LatencyThreshold = 0
+	cfg.DecreaseRatio = 1
+	require.EqualError(t, cfg.Validate(), "decrease_ratio must be in the range [0, 1)")
+
+	qCfg := NewDefaultQueueConfig()
+	qCfg.AdaptiveConcurrency = AdaptiveConcurrencyConfig{Enabled: true, MinConsumers: qCfg.NumConsumers + 1}
+	require.EqualError(t, qCfg.Validate(), "adaptive concurrency min_consumers must not be greater than the number of queue consumers")
+}
+
+func TestConcurrencyController_AIMD(t *testing.T) {
+	cc := newConcurrencyController(AdaptiveConcurrencyConfig{Enabled: true, MinConsumers: 2}, 10)
+	assert.Equal(t, 10, cc.concurrency())
+
+	// All the exports in flight when the destination starts throttling decrease the concurrency only once.
+	epoch := cc.currentEpoch()
+	throttleErr := NewThrottleRetry(errors.New("throttled"), time.Second)
+	cc.observe(epoch, time.Millisecond, throttleErr)
+	cc.observe(epoch, time.Millisecond, throttleErr)
+	assert.Equal(t, 5, cc.concurrency())
+
+	cc.observe(cc.currentEpoch(), time.Millisecond, status.Error(codes.ResourceExhausted, "overloaded"))
+	assert.Equal(t, 2, cc.concurrency())
+
+	// The concurrency never goes below the minimum.
+	cc.observe(cc.currentEpoch(), time.Millisecond, consumererror.NewPermanent(status.Error(codes.ResourceExhausted, "overloaded")))
+	assert.Equal(t, 2, cc.concurrency())
+
+	// Errors not related to throttling don't change the concurrency.
+	cc.observe(cc.currentEpoch(), time.Millisecond, errors.New("connection refused"))
+	assert.Equal(t, 2, cc.concurrency())
+	cc.observe(cc.currentEpoch(), time.Millisecond, NewThrottleRetry(status.Error(codes.Unavailable, "bad gateway"), 0))
+	assert.Equal(t, 2, cc.concurrency())
+
+	// The concurrency is increased by one after as many successful exports as the current concurrency.
+	cc.observe(cc.currentEpoch(), time.Millisecond, nil)
+	assert.Equal(t, 2, cc.concurrency())
+	cc.observe(cc.currentEpoch(), time.Millisecond, nil)
+	assert.Equal(t, 3, cc.concurrency())
+	for i := 0; i < 100; i++ {
+		cc.observe(cc.currentEpoch(), time.Millisecond, nil)
+	}
+	assert.Equal(t, 10, cc.concurrency())
+}
+
+func TestConcurrencyController_LatencyThreshold(t *testing.T) {
+	cc := newConcurrencyController(AdaptiveConcurrencyConfig{Enabled: true, LatencyThreshold: time.Second, DecreaseRatio: 0.8}, 10)
+
+	cc.observe(cc.currentEpoch(), time.Millisecond, nil)
+	assert.Equal(t, 10, cc.concurrency())
+
+	cc.observe(cc.currentEpoch(), 2*time.Second, nil)
+	assert.Equal(t, 8, cc.concurrency())
+}
+
+func TestConcurrencyController_AcquireBlocksOverLimit(t *testing.T) {
+	cc := newConcurrencyController(AdaptiveConcurrencyConfig{Enabled: true}, 2)
+	cc.observe(cc.currentEpoch(), time.Millisecond, NewThrottleRetry(errors.New("throttled"), time.Second))
+	require.Equal(t, 1, cc.concurrency())
+
+	cc.Acquire()
+	acquired := make(chan struct{})
+	go func() {
+		cc.Acquire()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("the second consumer must wait for the first one to finish")
+	case <-time.After(20 * time.Millisecond):
+	}
+	cc.Release()
+	<-acquired
+
+	// Once stopped, all the consumers are let through.
+	cc.stop()
+	cc.Acquire()
+	cc.Release()
+	cc.Release()
+}
+
+func TestQueuedRetry_AdaptiveConcurrency(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(defaultID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+	qCfg := NewDefaultQueueConfig()
+	qCfg.NumConsumers = 4
+	qCfg.AdaptiveConcurrency = AdaptiveConcurrencyConfig{Enabled: true}
+
+	var throttle atomic.Bool
+	throttle.Store(true)
+	var mu sync.Mutex
+	var active, maxActive int
+	push := func(context.Context, plog.Logs) error {
+		mu.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		if throttle.Load() {
+			return consumererror.NewPermanent(status.Error(codes.ResourceExhausted, "throttled"))
+		}
+		return nil
+	}
+	set := exporter.Settings{ID: defaultID, TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}
+	le, err := NewLogsExporter(context.Background(), set, &fakeLogsExporterConfig, push, WithQueue(qCfg))
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+
+	dataTypeAttr := attribute.String(internal.DataTypeKey, component.DataTypeLogs.String())
+	require.NoError(t, tt.CheckExporterMetricGauge("otelcol_exporter_queue_concurrency", 4, dataTypeAttr))
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(1)))
+	}
+	assert.Eventually(t, func() bool {
+		return tt.CheckExporterMetricGauge("otelcol_exporter_queue_concurrency", 1, dataTypeAttr) == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// Once the destination recovers, the concurrency grows back to the number of consumers.
+	throttle.Store(false)
+	mu.Lock()
+	maxActive = 0
+	mu.Unlock()
+	for i := 0; i < 50; i++ {
+		require.NoError(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(1)))
+	}
+	assert.Eventually(t, func() bool {
+		return tt.CheckExporterMetricGauge("otelcol_exporter_queue_concurrency", 4, dataTypeAttr) == nil
+	}, time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert.LessOrEqual(t, maxActive, 4)
+	mu.Unlock()
+}
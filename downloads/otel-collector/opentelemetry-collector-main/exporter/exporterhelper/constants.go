@@ -18,10 +18,16 @@ var (
 	errNilPushMetricsData = errors.New("nil PushMetrics")
 	// errNilPushLogsData is returned when a nil PushLogs is given.
 	errNilPushLogsData = errors.New("nil PushLogs")
+	// errNilPushProfilesData is returned when a nil PushProfiles is given.
+	errNilPushProfilesData = errors.New("nil PushProfiles")
 	// errNilTracesConverter is returned when a nil RequestFromTracesFunc is given.
 	errNilTracesConverter = errors.New("nil RequestFromTracesFunc")
 	// errNilMetricsConverter is returned when a nil RequestFromMetricsFunc is given.
 	errNilMetricsConverter = errors.New("nil RequestFromMetricsFunc")
 	// errNilLogsConverter is returned when a nil RequestFromLogsFunc is given.
 	errNilLogsConverter = errors.New("nil RequestFromLogsFunc")
+	// errNilProfilesConverter is returned when a nil RequestFromProfilesFunc is given.
+	errNilProfilesConverter = errors.New("nil RequestFromProfilesFunc")
+	// errProfilesMaxSizeBytes is returned when the batcher of a profiles exporter splits the batches by bytes.
+	errProfilesMaxSizeBytes = errors.New("max_size_bytes is not supported by the batcher of the profiles exporters")
 )
@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterqueue"
+	"go.opentelemetry.io/collector/exporter/internal/experr"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+const (
+	deadLetterKeyPrefix     = "dl_"
+	deadLetterReadIndexKey  = deadLetterKeyPrefix + "ri"
+	deadLetterWriteIndexKey = deadLetterKeyPrefix + "wi"
+)
+
+var (
+	errDeadLetterNotEnabled     = errors.New("dead-letter storage is not enabled for this exporter")
+	errDeadLetterStorageMissing = errors.New("dead-letter storage must be set when the dead-letter storage is enabled")
+	errDeadLetterNoStorage      = errors.New("dead-letter storage extension not found")
+	errDeadLetterWrongExtension = errors.New("requested dead-letter extension is not a storage extension")
+)
+
+// DeadLetterConfig defines configuration for storing requests that failed permanently,
+// or ran out of retries, so they can be replayed later.
+type DeadLetterConfig struct {
+	// Enabled indicates whether to store the failed requests.
+	Enabled bool `mapstructure:"enabled"`
+	// StorageID is the storage extension the failed requests are written to.
+	StorageID *component.ID `mapstructure:"storage"`
+	// ReplayOnStart replays the stored requests in the background when the exporter starts.
+	ReplayOnStart bool `mapstructure:"replay_on_start"`
+}
+
+// Validate checks if the DeadLetterConfig configuration is valid
+func (cfg *DeadLetterConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.StorageID == nil {
+		return errDeadLetterStorageMissing
+	}
+	return nil
+}
+
+// DeadLetterReplayer is implemented by the exporters created with this package.
+// It allows to resend the requests stored in the dead-letter storage.
+type DeadLetterReplayer interface {
+	// ReplayDeadLetters resends the stored requests in the order they failed, removing the ones
+	// that are sent successfully. It stops at the first request that fails again and
+	// returns the number of requests that were replayed.
+	ReplayDeadLetters(ctx context.Context) (int, error)
+}
+
+// deadLetterRecord is the value stored for every failed request.
+type deadLetterRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Reason    string    `json:"reason"`
+	Request   []byte    `json:"request"`
+}
+
+// deadLetterSender writes the requests that failed to be sent by the next senders to a storage extension.
+type deadLetterSender struct {
+	baseRequestSender
+	cfg         DeadLetterConfig
+	set         exporter.Settings
+	signal      component.DataType
+	marshaler   exporterqueue.Marshaler[Request]
+	unmarshaler exporterqueue.Unmarshaler[Request]
+	logger      *zap.Logger
+
+	// replaySender sends the replayed requests, so they are recorded by the exporter telemetry.
+	replaySender requestSender
+
+	// mu guards the storage client and the indices below.
+	mu         sync.Mutex
+	client     storage.Client
+	readIndex  uint64
+	writeIndex uint64
+
+	// replayMu ensures the stored requests are replayed only once at a time.
+	replayMu     sync.Mutex
+	replayCancel context.CancelFunc
+	replayWG     sync.WaitGroup
+}
+
+func newDeadLetterSender(cfg DeadLetterConfig, set exporter.Settings, signal component.DataType,
+	marshaler exporterqueue.Marshaler[Request], unmarshaler exporterqueue.Unmarshaler[Request]) *deadLetterSender {
+	return &deadLetterSender{
+		cfg:         cfg,
+		set:         set,
+		signal:      signal,
+		marshaler:   marshaler,
+		unmarshaler: unmarshaler,
+		logger:      set.Logger,
+	}
+}
+
+// Start gets the storage client and restores the indices of the stored requests.
+func (ds *deadLetterSender) Start(ctx context.Context, host component.Host) error {
+	ext, found := host.GetExtensions()[*ds.cfg.StorageID]
+	if !found {
+		return errDeadLetterNoStorage
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return errDeadLetterWrongExtension
+	}
+	client, err := storageExt.GetClient(ctx, component.KindExporter, ds.set.ID, "dead_letter_"+ds.signal.String())
+	if err != nil {
+		return err
+	}
+
+	riOp := storage.GetOperation(deadLetterReadIndexKey)
+	wiOp := storage.GetOperation(deadLetterWriteIndexKey)
+	if err = client.Batch(ctx, riOp, wiOp); err != nil {
+		return err
+	}
+
+	ds.mu.Lock()
+	ds.client = client
+	ds.readIndex = bytesToDeadLetterIndex(riOp.Value)
+	ds.writeIndex = bytesToDeadLetterIndex(wiOp.Value)
+	pending := ds.writeIndex - ds.readIndex
+	ds.mu.Unlock()
+
+	if pending > 0 {
+		ds.logger.Info("Found requests in the dead-letter storage", zap.Uint64("requests", pending))
+	}
+
+	if ds.cfg.ReplayOnStart && pending > 0 {
+		replayCtx, cancel := context.WithCancel(context.Background())
+		ds.replayCancel = cancel
+		ds.replayWG.Add(1)
+		go func() {
+			defer ds.replayWG.Done()
+			replayed, replayErr := ds.replay(replayCtx)
+			if replayErr != nil {
+				ds.logger.Warn("Replaying the dead-letter storage stopped", zap.Int("replayed", replayed), zap.Error(replayErr))
+				return
+			}
+			ds.logger.Info("Replayed the dead-letter storage", zap.Int("replayed", replayed))
+		}()
+	}
+	return nil
+}
+
+// Shutdown stops the replay in progress, if any, and closes the storage client.
+func (ds *deadLetterSender) Shutdown(ctx context.Context) error {
+	if ds.replayCancel != nil {
+		ds.replayCancel()
+	}
+	ds.replayWG.Wait()
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.client == nil {
+		return nil
+	}
+	err := ds.client.Close(ctx)
+	ds.client = nil
+	return err
+}
+
+// send implements the requestSender interface. Once the request is stored, the error is returned as permanent,
+// so the caller does not send it again and the request is not duplicated when it is replayed.
+func (ds *deadLetterSender) send(ctx context.Context, req Request) error {
+	err := ds.nextSender.send(ctx, req)
+	// Requests interrupted by the shutdown are put back into the queue, if any, so they are not stored.
+	if err == nil || experr.IsShutdownErr(err) {
+		return err
+	}
+
+	// The request may have failed because the context is done, store it regardless.
+	if storeErr := ds.store(context.WithoutCancel(ctx), req, err); storeErr != nil {
+		ds.logger.Error("Failed to write the request to the dead-letter storage",
+			zap.Error(storeErr), zap.Int("dropped_items", req.ItemsCount()))
+		return err
+	}
+	ds.logger.Warn("Request written to the dead-letter storage",
+		zap.Error(err), zap.Int("items", req.ItemsCount()))
+	return consumererror.NewPermanent(err)
+}
+
+func (ds *deadLetterSender) store(ctx context.Context, req Request, reason error) error {
+	reqBuf, err := ds.marshaler(req)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(deadLetterRecord{
+		Timestamp: time.Now(),
+		Reason:    reason.Error(),
+		Request:   reqBuf,
+	})
+	if err != nil {
+		return err
+	}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.client == nil {
+		return errDeadLetterNoStorage
+	}
+	if err = ds.client.Batch(ctx,
+		storage.SetOperation(getDeadLetterKey(ds.writeIndex), value),
+		storage.SetOperation(deadLetterWriteIndexKey, deadLetterIndexToBytes(ds.writeIndex+1)),
+	); err != nil {
+		return err
+	}
+	ds.writeIndex++
+	return nil
+}
+
+// replay resends the stored requests through the replay sender, bypassing the dead-letter storage,
+// so a request that fails again stays where it is.
+func (ds *deadLetterSender) replay(ctx context.Context) (int, error) {
+	ds.replayMu.Lock()
+	defer ds.replayMu.Unlock()
+	replayed := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return replayed, err
+		}
+
+		ds.mu.Lock()
+		if ds.client == nil {
+			ds.mu.Unlock()
+			return replayed, errDeadLetterNoStorage
+		}
+		client := ds.client
+		index := ds.readIndex
+		done := index >= ds.writeIndex
+		ds.mu.Unlock()
+		if done {
+			return replayed, nil
+		}
+
+		value, err := client.Get(ctx, getDeadLetterKey(index))
+		if err != nil {
+			return replayed, err
+		}
+
+		// A missing or corrupted record cannot be replayed, skip it.
+		if value != nil {
+			var req Request
+			req, err = ds.decode(value)
+			if err != nil {
+				ds.logger.Error("Skipping a corrupted dead-letter record", zap.Uint64("index", index), zap.Error(err))
+			} else if err = ds.replaySender.send(ctx, req); err != nil {
+				return replayed, fmt.Errorf("failed to replay the dead-letter record %d: %w", index, err)
+			} else {
+				replayed++
+			}
+		}
+
+		ds.mu.Lock()
+		err = client.Batch(ctx,
+			storage.DeleteOperation(getDeadLetterKey(index)),
+			storage.SetOperation(deadLetterReadIndexKey, deadLetterIndexToBytes(index+1)),
+		)
+		if err == nil {
+			ds.readIndex = index + 1
+		}
+		ds.mu.Unlock()
+		if err != nil {
+			return replayed, err
+		}
+	}
+}
+
+func (ds *deadLetterSender) decode(value []byte) (Request, error) {
+	var record deadLetterRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+	return ds.unmarshaler(record.Request)
+}
+
+func getDeadLetterKey(index uint64) string {
+	return deadLetterKeyPrefix + strconv.FormatUint(index, 10)
+}
+
+func deadLetterIndexToBytes(value uint64) []byte {
+	return binary.LittleEndian.AppendUint64([]byte{}, value)
+}
+
+// bytesToDeadLetterIndex returns 0 if the index was never written.
+func bytesToDeadLetterIndex(buf []byte) uint64 {
+	// The sizeof uint64 in binary is 8.
+	if len(buf) < 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(buf)
+}
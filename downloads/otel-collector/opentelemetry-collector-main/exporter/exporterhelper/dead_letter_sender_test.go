@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestDeadLetterConfig_Validate(t *testing.T) {
+	cfg := DeadLetterConfig{}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Enabled = true
+	assert.ErrorIs(t, cfg.Validate(), errDeadLetterStorageMissing)
+
+	storageID := component.MustNewID("file_storage")
+	cfg.StorageID = &storageID
+	assert.NoError(t, cfg.Validate())
+}
+
+// failingLogsSink fails every request with the given error while fail is set.
+type failingLogsSink struct {
+	consumertest.LogsSink
+	fail atomic.Bool
+	err  error
+}
+
+func (s *failingLogsSink) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if s.fail.Load() {
+		return s.err
+	}
+	return s.LogsSink.ConsumeLogs(ctx, ld)
+}
+
+func newDeadLetterTestExporter(t *testing.T, sink *failingLogsSink, cfg DeadLetterConfig, options ...Option) *logsExporter {
+	set := exportertest.NewNopSettings()
+	set.ID = component.MustNewIDWithName("test_logs", "with_dead_letter")
+	le, err := NewLogsExporter(context.Background(), set, &fakeLogsExporterConfig, sink.ConsumeLogs, append(options, WithDeadLetter(cfg))...)
+	require.NoError(t, err)
+	return le.(*logsExporter)
+}
+
+func newDeadLetterTestConfig(replayOnStart bool) (DeadLetterConfig, component.ID) {
+	storageID := component.MustNewIDWithName("file_storage", "dead_letter")
+	return DeadLetterConfig{Enabled: true, StorageID: &storageID, ReplayOnStart: replayOnStart}, storageID
+}
+
+func TestDeadLetter_PermanentErrorIsStoredAndReplayed(t *testing.T) {
+	cfg, storageID := newDeadLetterTestConfig(false)
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+	sink := &failingLogsSink{err: consumererror.NewPermanent(errors.New("bad data"))}
+	sink.fail.Store(true)
+	le := newDeadLetterTestExporter(t, sink, cfg)
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+
+	// The stored requests are reported as permanent failures, so the caller does not send them again.
+	err := le.ConsumeLogs(context.Background(), testdata.GenerateLogs(2))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	require.Error(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(3)))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// Replay stops at the first request that fails again.
+	replayed, err := le.ReplayDeadLetters(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 0, replayed)
+
+	sink.fail.Store(false)
+	replayed, err = le.ReplayDeadLetters(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, replayed)
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, 2, sink.AllLogs()[0].LogRecordCount())
+	assert.Equal(t, 3, sink.AllLogs()[1].LogRecordCount())
+
+	// Replayed requests are removed from the storage.
+	replayed, err = le.ReplayDeadLetters(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, replayed)
+}
+
+func TestDeadLetter_NoMoreRetriesIsStored(t *testing.T) {
+	cfg, storageID := newDeadLetterTestConfig(false)
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+	sink := &failingLogsSink{err: errors.New("transient error")}
+	sink.fail.Store(true)
+	rCfg := configretry.NewDefaultBackOffConfig()
+	rCfg.InitialInterval = time.Millisecond
+	rCfg.MaxElapsedTime = 10 * time.Millisecond
+	le := newDeadLetterTestExporter(t, sink, cfg, WithRetry(rCfg))
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+
+	err := le.ConsumeLogs(context.Background(), testdata.GenerateLogs(2))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+
+	sink.fail.Store(false)
+	replayed, err := le.ReplayDeadLetters(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+	assert.Equal(t, 2, sink.LogRecordCount())
+}
+
+func TestDeadLetter_StoreFailureIsNotPermanent(t *testing.T) {
+	cfg, storageID := newDeadLetterTestConfig(false)
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+	sink := &failingLogsSink{err: errors.New("transient error")}
+	sink.fail.Store(true)
+	le := newDeadLetterTestExporter(t, sink, cfg)
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+	require.NoError(t, le.deadLetterSender.Shutdown(context.Background()))
+
+	// The request could not be stored, so the original error is returned.
+	err := le.ConsumeLogs(context.Background(), testdata.GenerateLogs(2))
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestDeadLetter_ReplayIsRecorded(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(fakeLogsExporterName)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+	cfg, storageID := newDeadLetterTestConfig(false)
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+	sink := &failingLogsSink{err: consumererror.NewPermanent(errors.New("bad data"))}
+	sink.fail.Store(true)
+	exp, err := NewLogsExporter(context.Background(), exporter.Settings{ID: fakeLogsExporterName, TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()},
+		&fakeLogsExporterConfig, sink.ConsumeLogs, WithDeadLetter(cfg))
+	require.NoError(t, err)
+	le := exp.(*logsExporter)
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+
+	require.Error(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(2)))
+	require.NoError(t, tt.CheckExporterLogs(0, 2))
+
+	// A replay failing again is recorded as failed.
+	_, err = le.ReplayDeadLetters(context.Background())
+	require.Error(t, err)
+	require.NoError(t, tt.CheckExporterLogs(0, 4))
+
+	sink.fail.Store(false)
+	replayed, err := le.ReplayDeadLetters(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+	require.NoError(t, tt.CheckExporterLogs(2, 4))
+}
+
+func TestDeadLetter_ReplayOnStart(t *testing.T) {
+	cfg, storageID := newDeadLetterTestConfig(true)
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+
+	sink := &failingLogsSink{err: consumererror.NewPermanent(errors.New("bad data"))}
+	sink.fail.Store(true)
+	le := newDeadLetterTestExporter(t, sink, cfg)
+	require.NoError(t, le.Start(context.Background(), host))
+	require.Error(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(4)))
+	require.NoError(t, le.Shutdown(context.Background()))
+
+	// A new exporter using the same storage replays the stored requests on start.
+	sink = &failingLogsSink{}
+	le = newDeadLetterTestExporter(t, sink, cfg)
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 4
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDeadLetter_StorageNotFound(t *testing.T) {
+	cfg, _ := newDeadLetterTestConfig(false)
+	le := newDeadLetterTestExporter(t, &failingLogsSink{}, cfg)
+	require.ErrorIs(t, le.Start(context.Background(), &mockHost{}), errDeadLetterNoStorage)
+}
+
+func TestDeadLetter_NotEnabled(t *testing.T) {
+	le := newDeadLetterTestExporter(t, &failingLogsSink{}, DeadLetterConfig{})
+	require.NoError(t, le.Start(context.Background(), &mockHost{}))
+	t.Cleanup(func() { assert.NoError(t, le.Shutdown(context.Background())) })
+
+	_, err := le.ReplayDeadLetters(context.Background())
+	require.ErrorIs(t, err, errDeadLetterNotEnabled)
+}
+
+func TestDeadLetter_InvalidConfig(t *testing.T) {
+	_, err := NewLogsExporter(context.Background(), exportertest.NewNopSettings(), &fakeLogsExporterConfig, newPushLogsData(nil),
+		WithDeadLetter(DeadLetterConfig{Enabled: true}))
+	require.ErrorIs(t, err, errDeadLetterStorageMissing)
+}
+
+func TestDeadLetter_RequestExporterNotSupported(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	_, err := NewLogsRequestExporter(context.Background(), exportertest.NewNopSettings(), (&fakeRequestConverter{}).requestFromLogsFunc,
+		WithDeadLetter(DeadLetterConfig{Enabled: true, StorageID: &storageID}))
+	require.Error(t, err)
+}
@@ -32,14 +32,22 @@ type TelemetryBuilder struct {
 	meter                             metric.Meter
 	ExporterEnqueueFailedLogRecords   metric.Int64Counter
 	ExporterEnqueueFailedMetricPoints metric.Int64Counter
+	ExporterEnqueueFailedSamples      metric.Int64Counter
 	ExporterEnqueueFailedSpans        metric.Int64Counter
 	ExporterQueueCapacity             metric.Int64ObservableGauge
+	ExporterQueueConcurrency          metric.Int64ObservableGauge
 	ExporterQueueSize                 metric.Int64ObservableGauge
+	ExporterRejectedLogRecords        metric.Int64Counter
+	ExporterRejectedMetricPoints      metric.Int64Counter
+	ExporterRejectedSamples           metric.Int64Counter
+	ExporterRejectedSpans             metric.Int64Counter
 	ExporterSendFailedLogRecords      metric.Int64Counter
 	ExporterSendFailedMetricPoints    metric.Int64Counter
+	ExporterSendFailedSamples         metric.Int64Counter
 	ExporterSendFailedSpans           metric.Int64Counter
 	ExporterSentLogRecords            metric.Int64Counter
 	ExporterSentMetricPoints          metric.Int64Counter
+	ExporterSentSamples               metric.Int64Counter
 	ExporterSentSpans                 metric.Int64Counter
 	meters                            map[configtelemetry.Level]metric.Meter
 }
@@ -73,6 +81,24 @@ func (builder *TelemetryBuilder) InitExporterQueueCapacity(cb func() int64, opts
 	return err
 }
 
+// InitExporterQueueConcurrency configures the ExporterQueueConcurrency metric.
+func (builder *TelemetryBuilder) InitExporterQueueConcurrency(cb func() int64, opts ...metric.ObserveOption) error {
+	var err error
+	builder.ExporterQueueConcurrency, err = builder.meters[configtelemetry.LevelBasic].Int64ObservableGauge(
+		"otelcol_exporter_queue_concurrency",
+		metric.WithDescription("Current number of queue consumers allowed to export at the same time"),
+		metric.WithUnit("{consumers}"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = builder.meters[configtelemetry.LevelBasic].RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(builder.ExporterQueueConcurrency, cb(), opts...)
+		return nil
+	}, builder.ExporterQueueConcurrency)
+	return err
+}
+
 // InitExporterQueueSize configures the ExporterQueueSize metric.
 func (builder *TelemetryBuilder) InitExporterQueueSize(cb func() int64, opts ...metric.ObserveOption) error {
 	var err error
@@ -112,12 +138,42 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("{datapoints}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ExporterEnqueueFailedSamples, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_enqueue_failed_samples",
+		metric.WithDescription("Number of profile samples failed to be added to the sending queue."),
+		metric.WithUnit("{samples}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterEnqueueFailedSpans, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
 		"otelcol_exporter_enqueue_failed_spans",
 		metric.WithDescription("Number of spans failed to be added to the sending queue."),
 		metric.WithUnit("{spans}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ExporterRejectedLogRecords, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_rejected_log_records",
+		metric.WithDescription("Number of log records sent to destination but rejected by it, as reported by a partial success response."),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ExporterRejectedMetricPoints, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_rejected_metric_points",
+		metric.WithDescription("Number of metric points sent to destination but rejected by it, as reported by a partial success response."),
+		metric.WithUnit("{datapoints}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ExporterRejectedSamples, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_rejected_samples",
+		metric.WithDescription("Number of profile samples sent to destination but rejected by it, as reported by a partial success response."),
+		metric.WithUnit("{samples}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ExporterRejectedSpans, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_rejected_spans",
+		metric.WithDescription("Number of spans sent to destination but rejected by it, as reported by a partial success response."),
+		metric.WithUnit("{spans}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterSendFailedLogRecords, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
 		"otelcol_exporter_send_failed_log_records",
 		metric.WithDescription("Number of log records in failed attempts to send to destination."),
@@ -130,6 +186,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("{datapoints}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ExporterSendFailedSamples, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_send_failed_samples",
+		metric.WithDescription("Number of profile samples in failed attempts to send to destination."),
+		metric.WithUnit("{samples}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterSendFailedSpans, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
 		"otelcol_exporter_send_failed_spans",
 		metric.WithDescription("Number of spans in failed attempts to send to destination."),
@@ -148,6 +210,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("{datapoints}"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ExporterSentSamples, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
+		"otelcol_exporter_sent_samples",
+		metric.WithDescription("Number of profile samples successfully sent to destination."),
+		metric.WithUnit("{samples}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ExporterSentSpans, err = builder.meters[configtelemetry.LevelBasic].Int64Counter(
 		"otelcol_exporter_sent_spans",
 		metric.WithDescription("Number of spans successfully sent to destination."),
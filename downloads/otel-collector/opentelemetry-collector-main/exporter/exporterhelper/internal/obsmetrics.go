@@ -17,19 +17,37 @@ const (
 	SentSpansKey = "sent_spans"
 	// FailedToSendSpansKey used to track spans that failed to be sent by exporters.
 	FailedToSendSpansKey = "send_failed_spans"
+	// RejectedSpansKey used to track spans sent by exporters but rejected by the destination.
+	RejectedSpansKey = "rejected_spans"
 
 	// SentMetricPointsKey used to track metric points sent by exporters.
 	SentMetricPointsKey = "sent_metric_points"
 	// FailedToSendMetricPointsKey used to track metric points that failed to be sent by exporters.
 	FailedToSendMetricPointsKey = "send_failed_metric_points"
+	// RejectedMetricPointsKey used to track metric points sent by exporters but rejected by the destination.
+	RejectedMetricPointsKey = "rejected_metric_points"
 
 	// SentLogRecordsKey used to track logs sent by exporters.
 	SentLogRecordsKey = "sent_log_records"
 	// FailedToSendLogRecordsKey used to track logs that failed to be sent by exporters.
 	FailedToSendLogRecordsKey = "send_failed_log_records"
+	// RejectedLogRecordsKey used to track logs sent by exporters but rejected by the destination.
+	RejectedLogRecordsKey = "rejected_log_records"
+
+	// SentSamplesKey used to track profile samples sent by exporters.
+	SentSamplesKey = "sent_samples"
+	// FailedToSendSamplesKey used to track profile samples that failed to be sent by exporters.
+	FailedToSendSamplesKey = "send_failed_samples"
+	// RejectedSamplesKey used to track profile samples sent by exporters but rejected by the destination.
+	RejectedSamplesKey = "rejected_samples"
+
+	// ErrorClassKey used to identify the class of the error message returned by the destination
+	// along with the rejected items.
+	ErrorClassKey = "error_class"
 
 	ExporterPrefix                 = ExporterKey + spanNameSep
 	ExportTraceDataOperationSuffix = spanNameSep + "traces"
 	ExportMetricsOperationSuffix   = spanNameSep + "metrics"
 	ExportLogsOperationSuffix      = spanNameSep + "logs"
+	ExportProfilesOperationSuffix  = spanNameSep + "profiles"
 )
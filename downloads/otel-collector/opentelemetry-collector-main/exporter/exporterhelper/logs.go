@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
 	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
@@ -63,6 +64,20 @@ func (req *logsRequest) ItemsCount() int {
 	return req.ld.LogRecordCount()
 }
 
+func (req *logsRequest) resources() []pcommon.Resource {
+	rs := req.ld.ResourceLogs()
+	res := make([]pcommon.Resource, 0, rs.Len())
+	for i := 0; i < rs.Len(); i++ {
+		res = append(res, rs.At(i).Resource())
+	}
+	return res
+}
+
+// BytesSize returns the size of the request once marshaled to OTLP protobuf.
+func (req *logsRequest) BytesSize() int {
+	return logsMarshaler.LogsSize(req.ld)
+}
+
 type logsExporter struct {
 	*baseExporter
 	consumer.Logs
@@ -27,7 +27,8 @@ func mergeSplitLogs(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 Req
 	var (
 		res          []Request
 		destReq      *logsRequest
-		capacityLeft = cfg.MaxSizeItems
+		sz, capacity = newLogsSizer(cfg)
+		capacityLeft = capacity
 	)
 	for _, req := range []Request{r1, r2} {
 		if req == nil {
@@ -37,32 +38,43 @@ func mergeSplitLogs(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 Req
 		if !ok {
 			return nil, errors.New("invalid input type")
 		}
-		if srcReq.ld.LogRecordCount() <= capacityLeft {
+		if srcSize := sz.logsSize(srcReq.ld); srcSize <= capacityLeft {
 			if destReq == nil {
 				destReq = srcReq
 			} else {
 				srcReq.ld.ResourceLogs().MoveAndAppendTo(destReq.ld.ResourceLogs())
 			}
-			capacityLeft -= destReq.ld.LogRecordCount()
+			capacityLeft -= srcSize
 			continue
 		}
 
 		for {
-			extractedLogs := extractLogs(srcReq.ld, capacityLeft)
+			extractedLogs := extractLogs(srcReq.ld, capacityLeft, sz)
 			if extractedLogs.LogRecordCount() == 0 {
-				break
+				if srcReq.ld.LogRecordCount() == 0 {
+					break
+				}
+				// Nothing fits in the remaining capacity, create a new batch.
+				if destReq != nil {
+					res = append(res, destReq)
+					destReq = nil
+					capacityLeft = capacity
+					continue
+				}
+				// A single log record is bigger than the maximum size, send it on its own.
+				extractedLogs = extractLogs(srcReq.ld, 1, logsItemsSizer{})
 			}
-			capacityLeft -= extractedLogs.LogRecordCount()
+			capacityLeft -= sz.logsSize(extractedLogs)
 			if destReq == nil {
 				destReq = &logsRequest{ld: extractedLogs, pusher: srcReq.pusher}
 			} else {
 				extractedLogs.ResourceLogs().MoveAndAppendTo(destReq.ld.ResourceLogs())
 			}
 			// Create new batch once capacity is reached.
-			if capacityLeft == 0 {
+			if capacityLeft <= 0 {
 				res = append(res, destReq)
 				destReq = nil
-				capacityLeft = cfg.MaxSizeItems
+				capacityLeft = capacity
 			}
 		}
 	}
@@ -73,55 +85,131 @@ func mergeSplitLogs(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 Req
 	return res, nil
 }
 
-// extractLogs extracts logs from the input logs and returns a new logs with the specified number of log records.
-func extractLogs(srcLogs plog.Logs, count int) plog.Logs {
+// logsSizer computes the size of the logs and their elements, either in log records or in bytes.
+type logsSizer interface {
+	logsSize(ld plog.Logs) int
+	resourceLogsSize(rl plog.ResourceLogs) int
+	scopeLogsSize(sl plog.ScopeLogs) int
+	logRecordSize(lr plog.LogRecord) int
+	deltaSize(size int) int
+	contentCapacity(capacity int) int
+}
+
+// newLogsSizer returns the sizer and the maximum size of a batch for the given configuration.
+func newLogsSizer(cfg exporterbatcher.MaxSizeConfig) (logsSizer, int) {
+	if cfg.MaxSizeBytes > 0 {
+		return logsBytesSizer{}, cfg.MaxSizeBytes
+	}
+	return logsItemsSizer{}, cfg.MaxSizeItems
+}
+
+// logsItemsSizer computes the size of the logs in log records.
+type logsItemsSizer struct {
+	itemsSizeEncoding
+}
+
+func (logsItemsSizer) logsSize(ld plog.Logs) int {
+	return ld.LogRecordCount()
+}
+
+func (logsItemsSizer) resourceLogsSize(rl plog.ResourceLogs) int {
+	return resourceLogsCount(rl)
+}
+
+func (logsItemsSizer) scopeLogsSize(sl plog.ScopeLogs) int {
+	return sl.LogRecords().Len()
+}
+
+func (logsItemsSizer) logRecordSize(plog.LogRecord) int {
+	return 1
+}
+
+// logsBytesSizer computes the size of the logs in bytes of their OTLP protobuf encoding.
+type logsBytesSizer struct {
+	protoSizeEncoding
+}
+
+func (logsBytesSizer) logsSize(ld plog.Logs) int {
+	return logsMarshaler.LogsSize(ld)
+}
+
+func (logsBytesSizer) resourceLogsSize(rl plog.ResourceLogs) int {
+	return logsMarshaler.ResourceLogsSize(rl)
+}
+
+func (logsBytesSizer) scopeLogsSize(sl plog.ScopeLogs) int {
+	return logsMarshaler.ScopeLogsSize(sl)
+}
+
+func (logsBytesSizer) logRecordSize(lr plog.LogRecord) int {
+	return logsMarshaler.LogRecordSize(lr)
+}
+
+// extractLogs extracts logs from the input logs and returns new logs with a size not greater than the capacity.
+func extractLogs(srcLogs plog.Logs, capacity int, sz logsSizer) plog.Logs {
 	destLogs := plog.NewLogs()
+	capacityLeft := capacity - sz.logsSize(destLogs)
 	srcLogs.ResourceLogs().RemoveIf(func(srcRL plog.ResourceLogs) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := resourceLogsCount(srcRL) > count
-		if needToExtract {
-			srcRL = extractResourceLogs(srcRL, count)
+		rlSize := sz.deltaSize(sz.resourceLogsSize(srcRL))
+		if rlSize <= capacityLeft {
+			capacityLeft -= rlSize
+			srcRL.MoveTo(destLogs.ResourceLogs().AppendEmpty())
+			return true
+		}
+		if extractedRL := extractResourceLogs(srcRL, capacityLeft, sz); extractedRL.ScopeLogs().Len() > 0 {
+			extractedRL.MoveTo(destLogs.ResourceLogs().AppendEmpty())
 		}
-		count -= resourceLogsCount(srcRL)
-		srcRL.MoveTo(destLogs.ResourceLogs().AppendEmpty())
-		return !needToExtract
+		capacityLeft = 0
+		return false
 	})
 	return destLogs
 }
 
-// extractResourceLogs extracts resource logs and returns a new resource logs with the specified number of log records.
-func extractResourceLogs(srcRL plog.ResourceLogs, count int) plog.ResourceLogs {
+// extractResourceLogs extracts resource logs and returns a new resource logs with a size not greater than the capacity.
+func extractResourceLogs(srcRL plog.ResourceLogs, capacity int, sz logsSizer) plog.ResourceLogs {
 	destRL := plog.NewResourceLogs()
 	destRL.SetSchemaUrl(srcRL.SchemaUrl())
 	srcRL.Resource().CopyTo(destRL.Resource())
+	capacityLeft := sz.contentCapacity(capacity) - sz.resourceLogsSize(destRL)
 	srcRL.ScopeLogs().RemoveIf(func(srcSL plog.ScopeLogs) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := srcSL.LogRecords().Len() > count
-		if needToExtract {
-			srcSL = extractScopeLogs(srcSL, count)
+		slSize := sz.deltaSize(sz.scopeLogsSize(srcSL))
+		if slSize <= capacityLeft {
+			capacityLeft -= slSize
+			srcSL.MoveTo(destRL.ScopeLogs().AppendEmpty())
+			return true
 		}
-		count -= srcSL.LogRecords().Len()
-		srcSL.MoveTo(destRL.ScopeLogs().AppendEmpty())
-		return !needToExtract
+		if extractedSL := extractScopeLogs(srcSL, capacityLeft, sz); extractedSL.LogRecords().Len() > 0 {
+			extractedSL.MoveTo(destRL.ScopeLogs().AppendEmpty())
+		}
+		capacityLeft = 0
+		return false
 	})
 	return destRL
 }
 
-// extractScopeLogs extracts scope logs and returns a new scope logs with the specified number of log records.
-func extractScopeLogs(srcSL plog.ScopeLogs, count int) plog.ScopeLogs {
+// extractScopeLogs extracts scope logs and returns a new scope logs with a size not greater than the capacity.
+func extractScopeLogs(srcSL plog.ScopeLogs, capacity int, sz logsSizer) plog.ScopeLogs {
 	destSL := plog.NewScopeLogs()
 	destSL.SetSchemaUrl(srcSL.SchemaUrl())
 	srcSL.Scope().CopyTo(destSL.Scope())
+	capacityLeft := sz.contentCapacity(capacity) - sz.scopeLogsSize(destSL)
 	srcSL.LogRecords().RemoveIf(func(srcLR plog.LogRecord) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
+			return false
+		}
+		lrSize := sz.deltaSize(sz.logRecordSize(srcLR))
+		if lrSize > capacityLeft {
+			capacityLeft = 0
 			return false
 		}
+		capacityLeft -= lrSize
 		srcLR.MoveTo(destSL.LogRecords().AppendEmpty())
-		count--
 		return true
 	})
 	return destSL
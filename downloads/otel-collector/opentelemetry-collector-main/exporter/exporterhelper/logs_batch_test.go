@@ -153,8 +153,51 @@ func TestMergeSplitLogsInvalidInput(t *testing.T) {
 func TestExtractLogs(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		ld := testdata.GenerateLogs(10)
-		extractedLogs := extractLogs(ld, i)
+		extractedLogs := extractLogs(ld, i, logsItemsSizer{})
 		assert.Equal(t, i, extractedLogs.LogRecordCount())
 		assert.Equal(t, 10-i, ld.LogRecordCount())
 	}
 }
+
+func TestMergeSplitLogsBytes(t *testing.T) {
+	sz := logsBytesSizer{}
+	recordSize := sz.deltaSize(sz.logRecordSize(testdata.GenerateLogs(1).ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)))
+	for _, maxSize := range []int{recordSize / 2, 500, 1000, 2000, 4096} {
+		cfg := exporterbatcher.MaxSizeConfig{MaxSizeBytes: maxSize}
+		res, err := mergeSplitLogs(context.Background(), cfg, &logsRequest{ld: testdata.GenerateLogs(7)}, &logsRequest{ld: testdata.GenerateLogs(25)})
+		require.NoError(t, err)
+		count := 0
+		for _, r := range res {
+			ld := r.(*logsRequest).ld
+			count += ld.LogRecordCount()
+			if ld.LogRecordCount() > 1 {
+				assert.LessOrEqual(t, sz.logsSize(ld), maxSize)
+			}
+		}
+		assert.Equal(t, 32, count)
+		if maxSize < recordSize {
+			// Every log record is bigger than the maximum size and is sent on its own.
+			assert.Len(t, res, 32)
+		}
+	}
+}
+
+func TestMergeSplitLogsBytesMergeOnly(t *testing.T) {
+	lr1 := &logsRequest{ld: testdata.GenerateLogs(2)}
+	lr2 := &logsRequest{ld: testdata.GenerateLogs(3)}
+	cfg := exporterbatcher.MaxSizeConfig{MaxSizeBytes: lr1.BytesSize() + lr2.BytesSize()}
+	res, err := mergeSplitLogs(context.Background(), cfg, lr1, lr2)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, cfg.MaxSizeBytes, res[0].(*logsRequest).BytesSize())
+}
+
+func TestExtractLogsBytes(t *testing.T) {
+	sz := logsBytesSizer{}
+	for capacity := 0; capacity < 2000; capacity += 50 {
+		ld := testdata.GenerateLogs(10)
+		extractedLogs := extractLogs(ld, capacity, sz)
+		assert.LessOrEqual(t, sz.logsSize(extractedLogs), capacity)
+		assert.Equal(t, 10, extractedLogs.LogRecordCount()+ld.LogRecordCount())
+	}
+}
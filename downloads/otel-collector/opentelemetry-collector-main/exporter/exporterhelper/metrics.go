@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
 	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -63,6 +64,20 @@ func (req *metricsRequest) ItemsCount() int {
 	return req.md.DataPointCount()
 }
 
+func (req *metricsRequest) resources() []pcommon.Resource {
+	rs := req.md.ResourceMetrics()
+	res := make([]pcommon.Resource, 0, rs.Len())
+	for i := 0; i < rs.Len(); i++ {
+		res = append(res, rs.At(i).Resource())
+	}
+	return res
+}
+
+// BytesSize returns the size of the request once marshaled to OTLP protobuf.
+func (req *metricsRequest) BytesSize() int {
+	return metricsMarshaler.MetricsSize(req.md)
+}
+
 type metricsExporter struct {
 	*baseExporter
 	consumer.Metrics
@@ -27,7 +27,8 @@ func mergeSplitMetrics(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1
 	var (
 		res          []Request
 		destReq      *metricsRequest
-		capacityLeft = cfg.MaxSizeItems
+		sz, capacity = newMetricsSizer(cfg)
+		capacityLeft = capacity
 	)
 	for _, req := range []Request{r1, r2} {
 		if req == nil {
@@ -37,32 +38,43 @@ func mergeSplitMetrics(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1
 		if !ok {
 			return nil, errors.New("invalid input type")
 		}
-		if srcReq.md.DataPointCount() <= capacityLeft {
+		if srcSize := sz.metricsSize(srcReq.md); srcSize <= capacityLeft {
 			if destReq == nil {
 				destReq = srcReq
 			} else {
 				srcReq.md.ResourceMetrics().MoveAndAppendTo(destReq.md.ResourceMetrics())
 			}
-			capacityLeft -= destReq.md.DataPointCount()
+			capacityLeft -= srcSize
 			continue
 		}
 
 		for {
-			extractedMetrics := extractMetrics(srcReq.md, capacityLeft)
+			extractedMetrics := extractMetrics(srcReq.md, capacityLeft, sz)
 			if extractedMetrics.DataPointCount() == 0 {
-				break
+				if srcReq.md.DataPointCount() == 0 {
+					break
+				}
+				// Nothing fits in the remaining capacity, create a new batch.
+				if destReq != nil {
+					res = append(res, destReq)
+					destReq = nil
+					capacityLeft = capacity
+					continue
+				}
+				// A single data point is bigger than the maximum size, send it on its own.
+				extractedMetrics = extractMetrics(srcReq.md, 1, metricsItemsSizer{})
 			}
-			capacityLeft -= extractedMetrics.DataPointCount()
+			capacityLeft -= sz.metricsSize(extractedMetrics)
 			if destReq == nil {
 				destReq = &metricsRequest{md: extractedMetrics, pusher: srcReq.pusher}
 			} else {
 				extractedMetrics.ResourceMetrics().MoveAndAppendTo(destReq.md.ResourceMetrics())
 			}
 			// Create new batch once capacity is reached.
-			if capacityLeft == 0 {
+			if capacityLeft <= 0 {
 				res = append(res, destReq)
 				destReq = nil
-				capacityLeft = cfg.MaxSizeItems
+				capacityLeft = capacity
 			}
 		}
 	}
@@ -74,133 +86,269 @@ func mergeSplitMetrics(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1
 	return res, nil
 }
 
-// extractMetrics extracts metrics from srcMetrics until count of data points is reached.
-func extractMetrics(srcMetrics pmetric.Metrics, count int) pmetric.Metrics {
+// metricsSizer computes the size of the metrics and their elements, either in data points or in bytes.
+type metricsSizer interface {
+	metricsSize(md pmetric.Metrics) int
+	resourceMetricsSize(rm pmetric.ResourceMetrics) int
+	scopeMetricsSize(sm pmetric.ScopeMetrics) int
+	metricSize(m pmetric.Metric) int
+	numberDataPointSize(dp pmetric.NumberDataPoint) int
+	histogramDataPointSize(dp pmetric.HistogramDataPoint) int
+	exponentialHistogramDataPointSize(dp pmetric.ExponentialHistogramDataPoint) int
+	summaryDataPointSize(dp pmetric.SummaryDataPoint) int
+	// dataPointsCapacity returns the size of the data points that can be added to the metric
+	// without exceeding the capacity.
+	dataPointsCapacity(m pmetric.Metric, capacity int) int
+	deltaSize(size int) int
+	contentCapacity(capacity int) int
+}
+
+// newMetricsSizer returns the sizer and the maximum size of a batch for the given configuration.
+func newMetricsSizer(cfg exporterbatcher.MaxSizeConfig) (metricsSizer, int) {
+	if cfg.MaxSizeBytes > 0 {
+		return metricsBytesSizer{}, cfg.MaxSizeBytes
+	}
+	return metricsItemsSizer{}, cfg.MaxSizeItems
+}
+
+// metricsItemsSizer computes the size of the metrics in data points.
+type metricsItemsSizer struct {
+	itemsSizeEncoding
+}
+
+func (metricsItemsSizer) metricsSize(md pmetric.Metrics) int {
+	return md.DataPointCount()
+}
+
+func (metricsItemsSizer) resourceMetricsSize(rm pmetric.ResourceMetrics) int {
+	return resourceDataPointsCount(rm)
+}
+
+func (metricsItemsSizer) scopeMetricsSize(sm pmetric.ScopeMetrics) int {
+	return scopeDataPointsCount(sm)
+}
+
+func (metricsItemsSizer) metricSize(m pmetric.Metric) int {
+	return metricDataPointCount(m)
+}
+
+func (metricsItemsSizer) numberDataPointSize(pmetric.NumberDataPoint) int {
+	return 1
+}
+
+func (metricsItemsSizer) histogramDataPointSize(pmetric.HistogramDataPoint) int {
+	return 1
+}
+
+func (metricsItemsSizer) exponentialHistogramDataPointSize(pmetric.ExponentialHistogramDataPoint) int {
+	return 1
+}
+
+func (metricsItemsSizer) summaryDataPointSize(pmetric.SummaryDataPoint) int {
+	return 1
+}
+
+func (metricsItemsSizer) dataPointsCapacity(m pmetric.Metric, capacity int) int {
+	return capacity - metricDataPointCount(m)
+}
+
+// metricsBytesSizer computes the size of the metrics in bytes of their OTLP protobuf encoding.
+type metricsBytesSizer struct {
+	protoSizeEncoding
+}
+
+func (metricsBytesSizer) metricsSize(md pmetric.Metrics) int {
+	return metricsMarshaler.MetricsSize(md)
+}
+
+func (metricsBytesSizer) resourceMetricsSize(rm pmetric.ResourceMetrics) int {
+	return metricsMarshaler.ResourceMetricsSize(rm)
+}
+
+func (metricsBytesSizer) scopeMetricsSize(sm pmetric.ScopeMetrics) int {
+	return metricsMarshaler.ScopeMetricsSize(sm)
+}
+
+func (metricsBytesSizer) metricSize(m pmetric.Metric) int {
+	return metricsMarshaler.MetricSize(m)
+}
+
+func (metricsBytesSizer) numberDataPointSize(dp pmetric.NumberDataPoint) int {
+	return metricsMarshaler.NumberDataPointSize(dp)
+}
+
+func (metricsBytesSizer) histogramDataPointSize(dp pmetric.HistogramDataPoint) int {
+	return metricsMarshaler.HistogramDataPointSize(dp)
+}
+
+func (metricsBytesSizer) exponentialHistogramDataPointSize(dp pmetric.ExponentialHistogramDataPoint) int {
+	return metricsMarshaler.ExponentialHistogramDataPointSize(dp)
+}
+
+func (metricsBytesSizer) summaryDataPointSize(dp pmetric.SummaryDataPoint) int {
+	return metricsMarshaler.SummaryDataPointSize(dp)
+}
+
+// dataPointsCapacity accounts for the data points being encoded in the gauge, sum, histogram or summary message
+// nested in the metric: adding them also grows the varint encoded length of that message.
+func (sz metricsBytesSizer) dataPointsCapacity(m pmetric.Metric, capacity int) int {
+	metricCapacity := sz.contentCapacity(capacity)
+	return metricCapacity - sz.metricSize(m) + 1 - sov(metricCapacity)
+}
+
+// extractMetrics extracts metrics from srcMetrics and returns new metrics with a size not greater than the capacity.
+func extractMetrics(srcMetrics pmetric.Metrics, capacity int, sz metricsSizer) pmetric.Metrics {
 	destMetrics := pmetric.NewMetrics()
+	capacityLeft := capacity - sz.metricsSize(destMetrics)
 	srcMetrics.ResourceMetrics().RemoveIf(func(srcRM pmetric.ResourceMetrics) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := resourceDataPointsCount(srcRM) > count
-		if needToExtract {
-			srcRM = extractResourceMetrics(srcRM, count)
+		rmSize := sz.deltaSize(sz.resourceMetricsSize(srcRM))
+		if rmSize <= capacityLeft {
+			capacityLeft -= rmSize
+			srcRM.MoveTo(destMetrics.ResourceMetrics().AppendEmpty())
+			return true
 		}
-		count -= resourceDataPointsCount(srcRM)
-		srcRM.MoveTo(destMetrics.ResourceMetrics().AppendEmpty())
-		return !needToExtract
+		if extractedRM := extractResourceMetrics(srcRM, capacityLeft, sz); extractedRM.ScopeMetrics().Len() > 0 {
+			extractedRM.MoveTo(destMetrics.ResourceMetrics().AppendEmpty())
+		}
+		capacityLeft = 0
+		return false
 	})
 	return destMetrics
 }
 
-// extractResourceMetrics extracts resource metrics and returns a new resource metrics with the specified number of data points.
-func extractResourceMetrics(srcRM pmetric.ResourceMetrics, count int) pmetric.ResourceMetrics {
+// extractResourceMetrics extracts resource metrics and returns a new resource metrics with a size not greater than the capacity.
+func extractResourceMetrics(srcRM pmetric.ResourceMetrics, capacity int, sz metricsSizer) pmetric.ResourceMetrics {
 	destRM := pmetric.NewResourceMetrics()
 	destRM.SetSchemaUrl(srcRM.SchemaUrl())
 	srcRM.Resource().CopyTo(destRM.Resource())
+	capacityLeft := sz.contentCapacity(capacity) - sz.resourceMetricsSize(destRM)
 	srcRM.ScopeMetrics().RemoveIf(func(srcSM pmetric.ScopeMetrics) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := scopeDataPointsCount(srcSM) > count
-		if needToExtract {
-			srcSM = extractScopeMetrics(srcSM, count)
+		smSize := sz.deltaSize(sz.scopeMetricsSize(srcSM))
+		if smSize <= capacityLeft {
+			capacityLeft -= smSize
+			srcSM.MoveTo(destRM.ScopeMetrics().AppendEmpty())
+			return true
+		}
+		if extractedSM := extractScopeMetrics(srcSM, capacityLeft, sz); extractedSM.Metrics().Len() > 0 {
+			extractedSM.MoveTo(destRM.ScopeMetrics().AppendEmpty())
 		}
-		count -= scopeDataPointsCount(srcSM)
-		srcSM.MoveTo(destRM.ScopeMetrics().AppendEmpty())
-		return !needToExtract
+		capacityLeft = 0
+		return false
 	})
 	return destRM
 }
 
-// extractScopeMetrics extracts scope metrics and returns a new scope metrics with the specified number of data points.
-func extractScopeMetrics(srcSM pmetric.ScopeMetrics, count int) pmetric.ScopeMetrics {
+// extractScopeMetrics extracts scope metrics and returns a new scope metrics with a size not greater than the capacity.
+func extractScopeMetrics(srcSM pmetric.ScopeMetrics, capacity int, sz metricsSizer) pmetric.ScopeMetrics {
 	destSM := pmetric.NewScopeMetrics()
 	destSM.SetSchemaUrl(srcSM.SchemaUrl())
 	srcSM.Scope().CopyTo(destSM.Scope())
+	capacityLeft := sz.contentCapacity(capacity) - sz.scopeMetricsSize(destSM)
 	srcSM.Metrics().RemoveIf(func(srcMetric pmetric.Metric) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := metricDataPointCount(srcMetric) > count
-		if needToExtract {
-			srcMetric = extractMetricDataPoints(srcMetric, count)
+		mSize := sz.deltaSize(sz.metricSize(srcMetric))
+		if mSize <= capacityLeft {
+			capacityLeft -= mSize
+			srcMetric.MoveTo(destSM.Metrics().AppendEmpty())
+			return true
+		}
+		if extractedMetric := extractMetricDataPoints(srcMetric, capacityLeft, sz); metricDataPointCount(extractedMetric) > 0 {
+			extractedMetric.MoveTo(destSM.Metrics().AppendEmpty())
 		}
-		count -= metricDataPointCount(srcMetric)
-		srcMetric.MoveTo(destSM.Metrics().AppendEmpty())
-		return !needToExtract
+		capacityLeft = 0
+		return false
 	})
 	return destSM
 }
 
-func extractMetricDataPoints(srcMetric pmetric.Metric, count int) pmetric.Metric {
+// extractMetricDataPoints extracts data points and returns a new metric with a size not greater than the capacity.
+func extractMetricDataPoints(srcMetric pmetric.Metric, capacity int, sz metricsSizer) pmetric.Metric {
 	destMetric := pmetric.NewMetric()
+	destMetric.SetName(srcMetric.Name())
+	destMetric.SetDescription(srcMetric.Description())
+	destMetric.SetUnit(srcMetric.Unit())
+	srcMetric.Metadata().CopyTo(destMetric.Metadata())
 	switch srcMetric.Type() {
 	case pmetric.MetricTypeGauge:
-		extractGaugeDataPoints(srcMetric.Gauge(), count, destMetric.SetEmptyGauge())
+		destGauge := destMetric.SetEmptyGauge()
+		extractNumberDataPoints(srcMetric.Gauge().DataPoints(), sz.dataPointsCapacity(destMetric, capacity), sz, destGauge.DataPoints())
 	case pmetric.MetricTypeSum:
-		extractSumDataPoints(srcMetric.Sum(), count, destMetric.SetEmptySum())
+		destSum := destMetric.SetEmptySum()
+		destSum.SetAggregationTemporality(srcMetric.Sum().AggregationTemporality())
+		destSum.SetIsMonotonic(srcMetric.Sum().IsMonotonic())
+		extractNumberDataPoints(srcMetric.Sum().DataPoints(), sz.dataPointsCapacity(destMetric, capacity), sz, destSum.DataPoints())
 	case pmetric.MetricTypeHistogram:
-		extractHistogramDataPoints(srcMetric.Histogram(), count, destMetric.SetEmptyHistogram())
+		destHistogram := destMetric.SetEmptyHistogram()
+		destHistogram.SetAggregationTemporality(srcMetric.Histogram().AggregationTemporality())
+		extractHistogramDataPoints(srcMetric.Histogram().DataPoints(), sz.dataPointsCapacity(destMetric, capacity), sz, destHistogram.DataPoints())
 	case pmetric.MetricTypeExponentialHistogram:
-		extractExponentialHistogramDataPoints(srcMetric.ExponentialHistogram(), count,
-			destMetric.SetEmptyExponentialHistogram())
+		destExponentialHistogram := destMetric.SetEmptyExponentialHistogram()
+		destExponentialHistogram.SetAggregationTemporality(srcMetric.ExponentialHistogram().AggregationTemporality())
+		extractExponentialHistogramDataPoints(srcMetric.ExponentialHistogram().DataPoints(), sz.dataPointsCapacity(destMetric, capacity), sz,
+			destExponentialHistogram.DataPoints())
 	case pmetric.MetricTypeSummary:
-		extractSummaryDataPoints(srcMetric.Summary(), count, destMetric.SetEmptySummary())
+		extractSummaryDataPoints(srcMetric.Summary().DataPoints(), sz.dataPointsCapacity(destMetric, capacity), sz, destMetric.SetEmptySummary().DataPoints())
 	}
 	return destMetric
 }
 
-func extractGaugeDataPoints(srcGauge pmetric.Gauge, count int, destGauge pmetric.Gauge) {
-	srcGauge.DataPoints().RemoveIf(func(srcDP pmetric.NumberDataPoint) bool {
-		if count == 0 {
-			return false
-		}
-		srcDP.MoveTo(destGauge.DataPoints().AppendEmpty())
-		count--
-		return true
-	})
-}
-
-func extractSumDataPoints(srcSum pmetric.Sum, count int, destSum pmetric.Sum) {
-	srcSum.DataPoints().RemoveIf(func(srcDP pmetric.NumberDataPoint) bool {
-		if count == 0 {
+func extractNumberDataPoints(srcDPs pmetric.NumberDataPointSlice, capacity int, sz metricsSizer, destDPs pmetric.NumberDataPointSlice) {
+	srcDPs.RemoveIf(func(srcDP pmetric.NumberDataPoint) bool {
+		dpSize := sz.deltaSize(sz.numberDataPointSize(srcDP))
+		if dpSize > capacity {
+			capacity = 0
 			return false
 		}
-		srcDP.MoveTo(destSum.DataPoints().AppendEmpty())
-		count--
+		capacity -= dpSize
+		srcDP.MoveTo(destDPs.AppendEmpty())
 		return true
 	})
 }
 
-func extractHistogramDataPoints(srcHistogram pmetric.Histogram, count int, destHistogram pmetric.Histogram) {
-	srcHistogram.DataPoints().RemoveIf(func(srcDP pmetric.HistogramDataPoint) bool {
-		if count == 0 {
+func extractHistogramDataPoints(srcDPs pmetric.HistogramDataPointSlice, capacity int, sz metricsSizer, destDPs pmetric.HistogramDataPointSlice) {
+	srcDPs.RemoveIf(func(srcDP pmetric.HistogramDataPoint) bool {
+		dpSize := sz.deltaSize(sz.histogramDataPointSize(srcDP))
+		if dpSize > capacity {
+			capacity = 0
 			return false
 		}
-		srcDP.MoveTo(destHistogram.DataPoints().AppendEmpty())
-		count--
+		capacity -= dpSize
+		srcDP.MoveTo(destDPs.AppendEmpty())
 		return true
 	})
 }
 
-func extractExponentialHistogramDataPoints(srcExponentialHistogram pmetric.ExponentialHistogram, count int, destExponentialHistogram pmetric.ExponentialHistogram) {
-	srcExponentialHistogram.DataPoints().RemoveIf(func(srcDP pmetric.ExponentialHistogramDataPoint) bool {
-		if count == 0 {
+func extractExponentialHistogramDataPoints(srcDPs pmetric.ExponentialHistogramDataPointSlice, capacity int, sz metricsSizer,
+	destDPs pmetric.ExponentialHistogramDataPointSlice) {
+	srcDPs.RemoveIf(func(srcDP pmetric.ExponentialHistogramDataPoint) bool {
+		dpSize := sz.deltaSize(sz.exponentialHistogramDataPointSize(srcDP))
+		if dpSize > capacity {
+			capacity = 0
 			return false
 		}
-		srcDP.MoveTo(destExponentialHistogram.DataPoints().AppendEmpty())
-		count--
+		capacity -= dpSize
+		srcDP.MoveTo(destDPs.AppendEmpty())
 		return true
 	})
 }
 
-func extractSummaryDataPoints(srcSummary pmetric.Summary, count int, destSummary pmetric.Summary) {
-	srcSummary.DataPoints().RemoveIf(func(srcDP pmetric.SummaryDataPoint) bool {
-		if count == 0 {
+func extractSummaryDataPoints(srcDPs pmetric.SummaryDataPointSlice, capacity int, sz metricsSizer, destDPs pmetric.SummaryDataPointSlice) {
+	srcDPs.RemoveIf(func(srcDP pmetric.SummaryDataPoint) bool {
+		dpSize := sz.deltaSize(sz.summaryDataPointSize(srcDP))
+		if dpSize > capacity {
+			capacity = 0
 			return false
 		}
-		srcDP.MoveTo(destSummary.DataPoints().AppendEmpty())
-		count--
+		capacity -= dpSize
+		srcDP.MoveTo(destDPs.AppendEmpty())
 		return true
 	})
 }
@@ -153,7 +153,7 @@ func TestMergeSplitMetricsInvalidInput(t *testing.T) {
 func TestExtractMetrics(t *testing.T) {
 	for i := 0; i < 20; i++ {
 		md := testdata.GenerateMetrics(10)
-		extractedMetrics := extractMetrics(md, i)
+		extractedMetrics := extractMetrics(md, i, metricsItemsSizer{})
 		assert.Equal(t, i, extractedMetrics.DataPointCount())
 		assert.Equal(t, 20-i, md.DataPointCount())
 	}
@@ -161,7 +161,52 @@ func TestExtractMetrics(t *testing.T) {
 
 func TestExtractMetricsInvalidMetric(t *testing.T) {
 	md := testdata.GenerateMetricsMetricTypeInvalid()
-	extractedMetrics := extractMetrics(md, 10)
+	extractedMetrics := extractMetrics(md, 10, metricsItemsSizer{})
 	assert.Equal(t, testdata.GenerateMetricsMetricTypeInvalid(), extractedMetrics)
 	assert.Equal(t, 0, md.ResourceMetrics().Len())
 }
+
+func TestMergeSplitMetricsBytes(t *testing.T) {
+	sz := metricsBytesSizer{}
+	for _, maxSize := range []int{10, 200, 500, 1000, 4096} {
+		cfg := exporterbatcher.MaxSizeConfig{MaxSizeBytes: maxSize}
+		res, err := mergeSplitMetrics(context.Background(), cfg, &metricsRequest{md: testdata.GenerateMetrics(7)}, &metricsRequest{md: testdata.GenerateMetrics(25)})
+		require.NoError(t, err)
+		count := 0
+		for _, r := range res {
+			md := r.(*metricsRequest).md
+			count += md.DataPointCount()
+			if md.DataPointCount() > 1 {
+				assert.LessOrEqual(t, sz.metricsSize(md), maxSize)
+			}
+		}
+		assert.Equal(t, 64, count)
+		if maxSize == 10 {
+			// Every data point is bigger than the maximum size and is sent on its own.
+			assert.Len(t, res, 64)
+		}
+	}
+}
+
+func TestExtractMetricsBytes(t *testing.T) {
+	sz := metricsBytesSizer{}
+	for capacity := 0; capacity < 3000; capacity += 25 {
+		md := testdata.GenerateMetrics(10)
+		extractedMetrics := extractMetrics(md, capacity, sz)
+		assert.LessOrEqual(t, sz.metricsSize(extractedMetrics), capacity)
+		assert.Equal(t, 20, extractedMetrics.DataPointCount()+md.DataPointCount())
+	}
+}
+
+func TestExtractMetricDataPointsKeepsMetricFields(t *testing.T) {
+	md := testdata.GenerateMetrics(5)
+	srcMetric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(2)
+	require.Equal(t, pmetric.MetricTypeSum, srcMetric.Type())
+	extractedMetric := extractMetricDataPoints(srcMetric, 1, metricsItemsSizer{})
+	assert.Equal(t, srcMetric.Name(), extractedMetric.Name())
+	assert.Equal(t, srcMetric.Unit(), extractedMetric.Unit())
+	assert.Equal(t, srcMetric.Sum().AggregationTemporality(), extractedMetric.Sum().AggregationTemporality())
+	assert.Equal(t, srcMetric.Sum().IsMonotonic(), extractedMetric.Sum().IsMonotonic())
+	assert.Equal(t, 1, extractedMetric.Sum().DataPoints().Len())
+	assert.Equal(t, 1, srcMetric.Sum().DataPoints().Len())
+}
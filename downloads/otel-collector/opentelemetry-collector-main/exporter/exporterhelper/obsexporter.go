@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
 	"go.opentelemetry.io/collector/exporter/exporterhelper/internal/metadata"
@@ -25,6 +26,9 @@ type obsReport struct {
 
 	otelAttrs        []attribute.KeyValue
 	telemetryBuilder *metadata.TelemetryBuilder
+
+	errorClasses    errorClasses
+	rejectionStatus rejectionStatus
 }
 
 // obsReportSettings are settings for creating an obsReport.
@@ -60,9 +64,10 @@ func (or *obsReport) startTracesOp(ctx context.Context) context.Context {
 
 // endTracesOp completes the export operation that was started with startTracesOp.
 func (or *obsReport) endTracesOp(ctx context.Context, numSpans int, err error) {
-	numSent, numFailedToSend := toNumItems(numSpans, err)
+	numSent, numFailedToSend, numRejected, errorMessage := or.toNumItems(ctx, numSpans, err)
 	or.recordMetrics(context.WithoutCancel(ctx), component.DataTypeTraces, numSent, numFailedToSend)
-	endSpan(ctx, err, numSent, numFailedToSend, internal.SentSpansKey, internal.FailedToSendSpansKey)
+	or.recordRejected(context.WithoutCancel(ctx), component.DataTypeTraces, numRejected, errorMessage)
+	endSpan(ctx, err, numSent, numFailedToSend, numRejected, internal.SentSpansKey, internal.FailedToSendSpansKey, internal.RejectedSpansKey)
 }
 
 // startMetricsOp is called at the start of an Export operation.
@@ -77,9 +82,10 @@ func (or *obsReport) startMetricsOp(ctx context.Context) context.Context {
 //
 // If needed, report your use case in https://github.com/open-telemetry/opentelemetry-collector/issues/10592.
 func (or *obsReport) endMetricsOp(ctx context.Context, numMetricPoints int, err error) {
-	numSent, numFailedToSend := toNumItems(numMetricPoints, err)
+	numSent, numFailedToSend, numRejected, errorMessage := or.toNumItems(ctx, numMetricPoints, err)
 	or.recordMetrics(context.WithoutCancel(ctx), component.DataTypeMetrics, numSent, numFailedToSend)
-	endSpan(ctx, err, numSent, numFailedToSend, internal.SentMetricPointsKey, internal.FailedToSendMetricPointsKey)
+	or.recordRejected(context.WithoutCancel(ctx), component.DataTypeMetrics, numRejected, errorMessage)
+	endSpan(ctx, err, numSent, numFailedToSend, numRejected, internal.SentMetricPointsKey, internal.FailedToSendMetricPointsKey, internal.RejectedMetricPointsKey)
 }
 
 // startLogsOp is called at the start of an Export operation.
@@ -91,9 +97,25 @@ func (or *obsReport) startLogsOp(ctx context.Context) context.Context {
 
 // endLogsOp completes the export operation that was started with startLogsOp.
 func (or *obsReport) endLogsOp(ctx context.Context, numLogRecords int, err error) {
-	numSent, numFailedToSend := toNumItems(numLogRecords, err)
+	numSent, numFailedToSend, numRejected, errorMessage := or.toNumItems(ctx, numLogRecords, err)
 	or.recordMetrics(context.WithoutCancel(ctx), component.DataTypeLogs, numSent, numFailedToSend)
-	endSpan(ctx, err, numSent, numFailedToSend, internal.SentLogRecordsKey, internal.FailedToSendLogRecordsKey)
+	or.recordRejected(context.WithoutCancel(ctx), component.DataTypeLogs, numRejected, errorMessage)
+	endSpan(ctx, err, numSent, numFailedToSend, numRejected, internal.SentLogRecordsKey, internal.FailedToSendLogRecordsKey, internal.RejectedLogRecordsKey)
+}
+
+// startProfilesOp is called at the start of an Export operation.
+// The returned context should be used in other calls to the Exporter functions
+// dealing with the same export operation.
+func (or *obsReport) startProfilesOp(ctx context.Context) context.Context {
+	return or.startOp(ctx, internal.ExportProfilesOperationSuffix)
+}
+
+// endProfilesOp completes the export operation that was started with startProfilesOp.
+func (or *obsReport) endProfilesOp(ctx context.Context, numSamples int, err error) {
+	numSent, numFailedToSend, numRejected, errorMessage := or.toNumItems(ctx, numSamples, err)
+	or.recordMetrics(context.WithoutCancel(ctx), componentprofiles.DataTypeProfiles, numSent, numFailedToSend)
+	or.recordRejected(context.WithoutCancel(ctx), componentprofiles.DataTypeProfiles, numRejected, errorMessage)
+	endSpan(ctx, err, numSent, numFailedToSend, numRejected, internal.SentSamplesKey, internal.FailedToSendSamplesKey, internal.RejectedSamplesKey)
 }
 
 // startOp creates the span used to trace the operation. Returning
@@ -101,7 +123,7 @@ func (or *obsReport) endLogsOp(ctx context.Context, numLogRecords int, err error
 func (or *obsReport) startOp(ctx context.Context, operationSuffix string) context.Context {
 	spanName := or.spanNamePrefix + operationSuffix
 	ctx, _ = or.tracer.Start(ctx, spanName)
-	return ctx
+	return contextWithPartialSuccess(ctx)
 }
 
 func (or *obsReport) recordMetrics(ctx context.Context, dataType component.DataType, sent, failed int64) {
@@ -116,13 +138,37 @@ func (or *obsReport) recordMetrics(ctx context.Context, dataType component.DataT
 	case component.DataTypeLogs:
 		sentMeasure = or.telemetryBuilder.ExporterSentLogRecords
 		failedMeasure = or.telemetryBuilder.ExporterSendFailedLogRecords
+	case componentprofiles.DataTypeProfiles:
+		sentMeasure = or.telemetryBuilder.ExporterSentSamples
+		failedMeasure = or.telemetryBuilder.ExporterSendFailedSamples
 	}
 
 	sentMeasure.Add(ctx, sent, metric.WithAttributes(or.otelAttrs...))
 	failedMeasure.Add(ctx, failed, metric.WithAttributes(or.otelAttrs...))
 }
 
-func endSpan(ctx context.Context, err error, numSent, numFailedToSend int64, sentItemsKey, failedToSendItemsKey string) {
+// recordRejected records the items rejected by the destination with the class of the returned error message.
+func (or *obsReport) recordRejected(ctx context.Context, dataType component.DataType, rejected int64, errorMessage string) {
+	if rejected == 0 {
+		return
+	}
+	var rejectedMeasure metric.Int64Counter
+	switch dataType {
+	case component.DataTypeTraces:
+		rejectedMeasure = or.telemetryBuilder.ExporterRejectedSpans
+	case component.DataTypeMetrics:
+		rejectedMeasure = or.telemetryBuilder.ExporterRejectedMetricPoints
+	case component.DataTypeLogs:
+		rejectedMeasure = or.telemetryBuilder.ExporterRejectedLogRecords
+	case componentprofiles.DataTypeProfiles:
+		rejectedMeasure = or.telemetryBuilder.ExporterRejectedSamples
+	}
+
+	attrs := append([]attribute.KeyValue{attribute.String(internal.ErrorClassKey, or.errorClasses.classOf(errorMessage))}, or.otelAttrs...)
+	rejectedMeasure.Add(ctx, rejected, metric.WithAttributes(attrs...))
+}
+
+func endSpan(ctx context.Context, err error, numSent, numFailedToSend, numRejected int64, sentItemsKey, failedToSendItemsKey, rejectedItemsKey string) {
 	span := trace.SpanFromContext(ctx)
 	// End the span according to errors.
 	if span.IsRecording() {
@@ -130,6 +176,9 @@ func endSpan(ctx context.Context, err error, numSent, numFailedToSend int64, sen
 			attribute.Int64(sentItemsKey, numSent),
 			attribute.Int64(failedToSendItemsKey, numFailedToSend),
 		)
+		if numRejected > 0 {
+			span.SetAttributes(attribute.Int64(rejectedItemsKey, numRejected))
+		}
 		if err != nil {
 			span.SetStatus(codes.Error, err.Error())
 		}
@@ -137,11 +186,21 @@ func endSpan(ctx context.Context, err error, numSent, numFailedToSend int64, sen
 	span.End()
 }
 
-func toNumItems(numExportedItems int, err error) (int64, int64) {
+// toNumItems returns the number of sent, failed and rejected items of an export operation,
+// and the error message returned by the destination along with the rejected items.
+func (or *obsReport) toNumItems(ctx context.Context, numExportedItems int, err error) (int64, int64, int64, string) {
 	if err != nil {
-		return 0, int64(numExportedItems)
+		return 0, int64(numExportedItems), 0, ""
+	}
+	numRejected, errorMessage := partialSuccessFromContext(ctx)
+	if numRejected < 0 {
+		numRejected = 0
+	}
+	if numRejected > int64(numExportedItems) {
+		numRejected = int64(numExportedItems)
 	}
-	return int64(numExportedItems), 0
+	or.rejectionStatus.observe(numRejected, errorMessage)
+	return int64(numExportedItems) - numRejected, 0, numRejected, errorMessage
 }
 
 func (or *obsReport) recordEnqueueFailure(ctx context.Context, dataType component.DataType, failed int64) {
@@ -153,6 +212,8 @@ func (or *obsReport) recordEnqueueFailure(ctx context.Context, dataType componen
 		enqueueFailedMeasure = or.telemetryBuilder.ExporterEnqueueFailedMetricPoints
 	case component.DataTypeLogs:
 		enqueueFailedMeasure = or.telemetryBuilder.ExporterEnqueueFailedLogRecords
+	case componentprofiles.DataTypeProfiles:
+		enqueueFailedMeasure = or.telemetryBuilder.ExporterEnqueueFailedSamples
 	}
 
 	enqueueFailedMeasure.Add(ctx, failed, metric.WithAttributes(or.otelAttrs...))
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+const (
+	// rejectionStatusThreshold is the number of consecutive exports with rejected items
+	// after which a recoverable error status is reported.
+	rejectionStatusThreshold = 5
+
+	// maxErrorClasses limits the number of distinct error classes recorded by an exporter.
+	maxErrorClasses = 32
+	// maxErrorClassLength limits the length of an error class.
+	maxErrorClassLength = 64
+
+	unknownErrorClass = "unknown"
+	otherErrorClass   = "other"
+)
+
+// ReportPartialSuccess reports that the destination accepted the request exported with the given context,
+// but rejected rejectedItems of its items with the given error message, as described by the OTLP partial success
+// responses. The rejected items are counted by the otelcol_exporter_rejected_* metrics instead of the
+// otelcol_exporter_sent_* ones.
+//
+// It must be called by the push functions with the context they were given, before returning a nil error.
+// It's a no-op if the context doesn't come from an exporter created with this package.
+func ReportPartialSuccess(ctx context.Context, rejectedItems int64, errorMessage string) {
+	if ps, ok := ctx.Value(partialSuccessKey{}).(*partialSuccess); ok {
+		ps.add(rejectedItems, errorMessage)
+	}
+}
+
+type partialSuccessKey struct{}
+
+// partialSuccess collects the items rejected by the destination during an export operation.
+type partialSuccess struct {
+	mu           sync.Mutex
+	rejected     int64
+	errorMessage string
+}
+
+func contextWithPartialSuccess(ctx context.Context) context.Context {
+	return context.WithValue(ctx, partialSuccessKey{}, &partialSuccess{})
+}
+
+func partialSuccessFromContext(ctx context.Context) (int64, string) {
+	ps, ok := ctx.Value(partialSuccessKey{}).(*partialSuccess)
+	if !ok {
+		return 0, ""
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.rejected, ps.errorMessage
+}
+
+func (ps *partialSuccess) add(rejected int64, errorMessage string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.rejected += rejected
+	if errorMessage != "" {
+		ps.errorMessage = errorMessage
+	}
+}
+
+// errorClasses maps the error messages returned along with the rejected items to a bounded set of classes,
+// so they can be used as a metric attribute.
+type errorClasses struct {
+	mu      sync.Mutex
+	classes map[string]struct{}
+}
+
+func (ec *errorClasses) classOf(errorMessage string) string {
+	class := errorMessageClass(errorMessage)
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+	if _, ok := ec.classes[class]; ok {
+		return class
+	}
+	if len(ec.classes) >= maxErrorClasses {
+		return otherErrorClass
+	}
+	if ec.classes == nil {
+		ec.classes = map[string]struct{}{}
+	}
+	ec.classes[class] = struct{}{}
+	return class
+}
+
+// errorMessageClass returns the first clause of the error message, lower-cased and without digits,
+// so the messages only differing by the values they reference share the same class.
+func errorMessageClass(errorMessage string) string {
+	if i := strings.IndexAny(errorMessage, ":;\n"); i >= 0 {
+		errorMessage = errorMessage[:i]
+	}
+	errorMessage = strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, errorMessage)
+	errorMessage = strings.Join(strings.Fields(errorMessage), " ")
+	if len(errorMessage) > maxErrorClassLength {
+		errorMessage = errorMessage[:maxErrorClassLength]
+		for !utf8.ValidString(errorMessage) {
+			errorMessage = errorMessage[:len(errorMessage)-1]
+		}
+	}
+	if errorMessage == "" {
+		return unknownErrorClass
+	}
+	return errorMessage
+}
+
+// rejectionStatus reports a recoverable error status when the destination keeps rejecting items,
+// and an OK status once an export is fully accepted again.
+type rejectionStatus struct {
+	mu          sync.Mutex
+	host        component.Host
+	consecutive int
+	reported    bool
+}
+
+func (rs *rejectionStatus) setHost(host component.Host) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.host = host
+}
+
+// observe is called with the outcome of every successful export.
+func (rs *rejectionStatus) observe(rejected int64, errorMessage string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if rejected == 0 {
+		rs.consecutive = 0
+		if rs.reported {
+			rs.reported = false
+			rs.report(componentstatus.NewEvent(componentstatus.StatusOK))
+		}
+		return
+	}
+	rs.consecutive++
+	if rs.consecutive >= rejectionStatusThreshold && !rs.reported {
+		rs.reported = true
+		rs.report(componentstatus.NewRecoverableErrorEvent(
+			fmt.Errorf("the destination rejected items in %d consecutive exports: %s", rs.consecutive, errorMessage)))
+	}
+}
+
+// report must be called with the lock held.
+func (rs *rejectionStatus) report(ev *componentstatus.Event) {
+	if rs.host != nil {
+		componentstatus.ReportStatus(rs.host, ev)
+	}
+}
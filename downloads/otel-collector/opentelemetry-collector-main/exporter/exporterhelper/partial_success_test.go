@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestReportPartialSuccess(t *testing.T) {
+	ctx := contextWithPartialSuccess(context.Background())
+	ReportPartialSuccess(ctx, 2, "first")
+	ReportPartialSuccess(ctx, 3, "")
+	rejected, msg := partialSuccessFromContext(ctx)
+	assert.Equal(t, int64(5), rejected)
+	assert.Equal(t, "first", msg)
+
+	// No-op without the tracker.
+	ReportPartialSuccess(context.Background(), 2, "ignored")
+	rejected, msg = partialSuccessFromContext(context.Background())
+	assert.Zero(t, rejected)
+	assert.Empty(t, msg)
+}
+
+func TestErrorMessageClass(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{msg: "", want: unknownErrorClass},
+		{msg: "123: 456", want: unknownErrorClass},
+		{msg: "Invalid timestamp", want: "invalid timestamp"},
+		{msg: "invalid attribute 12: value too long", want: "invalid attribute"},
+		{msg: "  Label   limit exceeded; max 30", want: "label limit exceeded"},
+		{msg: "out of order sample\nseries foo", want: "out of order sample"},
+		{msg: strings.Repeat("a", 100), want: strings.Repeat("a", maxErrorClassLength)},
+		{msg: strings.Repeat("a", maxErrorClassLength-1) + "é", want: strings.Repeat("a", maxErrorClassLength-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorMessageClass(tt.msg))
+		})
+	}
+}
+
+func TestErrorClassesLimit(t *testing.T) {
+	var ec errorClasses
+	for i := 0; i < maxErrorClasses; i++ {
+		class := "class " + strings.Repeat("x", i+1)
+		assert.Equal(t, class, ec.classOf(class+": "+strconv.Itoa(i)))
+	}
+	assert.Equal(t, otherErrorClass, ec.classOf("new class"))
+	// Known classes are still recorded once the limit is reached.
+	assert.Equal(t, "class x", ec.classOf("class x"))
+}
+
+func TestRejectionStatus(t *testing.T) {
+	host := &statusRecordingHost{}
+	var rs rejectionStatus
+	rs.setHost(host)
+
+	for i := 0; i < rejectionStatusThreshold-1; i++ {
+		rs.observe(1, "rejected")
+	}
+	rs.observe(0, "")
+	assert.Empty(t, host.events)
+
+	for i := 0; i < rejectionStatusThreshold+2; i++ {
+		rs.observe(1, "rejected")
+	}
+	require.Len(t, host.events, 1)
+	assert.Equal(t, componentstatus.StatusRecoverableError, host.events[0].Status())
+	assert.ErrorContains(t, host.events[0].Err(), "rejected")
+
+	rs.observe(0, "")
+	rs.observe(0, "")
+	require.Len(t, host.events, 2)
+	assert.Equal(t, componentstatus.StatusOK, host.events[1].Status())
+}
+
+func TestLogsPartialSuccess(t *testing.T) {
+	tel := setupTestTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	host := &statusRecordingHost{Host: componenttest.NewNopHost()}
+	set := tel.NewSettings()
+	le, err := NewLogsExporter(context.Background(), set, &fakeLogsExporterConfig, func(ctx context.Context, ld plog.Logs) error {
+		ReportPartialSuccess(ctx, int64(ld.LogRecordCount()-1), "Invalid log record 42")
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, le.Shutdown(context.Background())) })
+
+	for i := 0; i < rejectionStatusThreshold; i++ {
+		require.NoError(t, le.ConsumeLogs(context.Background(), testdata.GenerateLogs(3)))
+	}
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tel.reader.Collect(context.Background(), &md))
+	attrs := attribute.NewSet(attribute.String(internal.ExporterKey, set.ID.String()))
+	assertSum(t, tel.getMetric("otelcol_exporter_sent_log_records", md), 5, attrs)
+	rejectedAttrs := attribute.NewSet(
+		attribute.String(internal.ErrorClassKey, "invalid log record"),
+		attribute.String(internal.ExporterKey, set.ID.String()))
+	assertSum(t, tel.getMetric("otelcol_exporter_rejected_log_records", md), 10, rejectedAttrs)
+
+	require.Len(t, host.events, 1)
+	assert.Equal(t, componentstatus.StatusRecoverableError, host.events[0].Status())
+}
+
+func assertSum(t *testing.T, m metricdata.Metrics, want int64, attrs attribute.Set) {
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok, "metric %q is not an int64 sum", m.Name)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, want, sum.DataPoints[0].Value)
+	assert.True(t, attrs.Equals(&sum.DataPoints[0].Attributes), "unexpected attributes %v", sum.DataPoints[0].Attributes.ToSlice())
+}
+
+type statusRecordingHost struct {
+	component.Host
+	events []*componentstatus.Event
+}
+
+func (h *statusRecordingHost) Report(ev *componentstatus.Event) {
+	h.events = append(h.events, ev)
+}
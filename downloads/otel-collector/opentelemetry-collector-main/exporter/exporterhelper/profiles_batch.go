@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/exporter/exporterbatcher"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// mergeProfiles merges two profiles requests into one.
+func mergeProfiles(_ context.Context, r1 Request, r2 Request) (Request, error) {
+	pr1, ok1 := r1.(*profilesRequest)
+	pr2, ok2 := r2.(*profilesRequest)
+	if !ok1 || !ok2 {
+		return nil, errors.New("invalid input type")
+	}
+	pr2.pd.ResourceProfiles().MoveAndAppendTo(pr1.pd.ResourceProfiles())
+	return pr1, nil
+}
+
+// mergeSplitProfiles splits and/or merges the profiles into multiple requests based on the MaxSizeItems of the
+// MaxSizeConfig. MaxSizeBytes is rejected when the profiles exporter is created.
+func mergeSplitProfiles(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 Request, r2 Request) ([]Request, error) {
+	var (
+		res          []Request
+		destReq      *profilesRequest
+		capacityLeft = cfg.MaxSizeItems
+	)
+	for _, req := range []Request{r1, r2} {
+		if req == nil {
+			continue
+		}
+		srcReq, ok := req.(*profilesRequest)
+		if !ok {
+			return nil, errors.New("invalid input type")
+		}
+		if srcReq.pd.SampleCount() <= capacityLeft {
+			if destReq == nil {
+				destReq = srcReq
+			} else {
+				srcReq.pd.ResourceProfiles().MoveAndAppendTo(destReq.pd.ResourceProfiles())
+			}
+			capacityLeft -= destReq.pd.SampleCount()
+			continue
+		}
+
+		for {
+			extractedProfiles := extractProfiles(srcReq.pd, capacityLeft)
+			if extractedProfiles.SampleCount() == 0 {
+				break
+			}
+			capacityLeft -= extractedProfiles.SampleCount()
+			if destReq == nil {
+				destReq = &profilesRequest{pd: extractedProfiles, pusher: srcReq.pusher}
+			} else {
+				extractedProfiles.ResourceProfiles().MoveAndAppendTo(destReq.pd.ResourceProfiles())
+			}
+			// Create new batch once capacity is reached.
+			if capacityLeft == 0 {
+				res = append(res, destReq)
+				destReq = nil
+				capacityLeft = cfg.MaxSizeItems
+			}
+		}
+	}
+
+	if destReq != nil {
+		res = append(res, destReq)
+	}
+	return res, nil
+}
+
+// extractProfiles extracts new profiles with a maximum number of samples.
+func extractProfiles(srcProfiles pprofile.Profiles, count int) pprofile.Profiles {
+	destProfiles := pprofile.NewProfiles()
+	srcProfiles.ResourceProfiles().RemoveIf(func(srcRP pprofile.ResourceProfiles) bool {
+		if count == 0 {
+			return false
+		}
+		needToExtract := resourceProfilesCount(srcRP) > count
+		if needToExtract {
+			srcRP = extractResourceProfiles(srcRP, count)
+		}
+		count -= resourceProfilesCount(srcRP)
+		srcRP.MoveTo(destProfiles.ResourceProfiles().AppendEmpty())
+		return !needToExtract
+	})
+	return destProfiles
+}
+
+// extractResourceProfiles extracts profiles and returns new resource profiles with the specified number of samples.
+func extractResourceProfiles(srcRP pprofile.ResourceProfiles, count int) pprofile.ResourceProfiles {
+	destRP := pprofile.NewResourceProfiles()
+	destRP.SetSchemaUrl(srcRP.SchemaUrl())
+	srcRP.Resource().CopyTo(destRP.Resource())
+	srcRP.ScopeProfiles().RemoveIf(func(srcSP pprofile.ScopeProfiles) bool {
+		if count == 0 {
+			return false
+		}
+		needToExtract := scopeProfilesCount(srcSP) > count
+		if needToExtract {
+			srcSP = extractScopeProfiles(srcSP, count)
+		}
+		count -= scopeProfilesCount(srcSP)
+		srcSP.MoveTo(destRP.ScopeProfiles().AppendEmpty())
+		return !needToExtract
+	})
+	return destRP
+}
+
+// extractScopeProfiles extracts profiles and returns new scope profiles with the specified number of samples.
+func extractScopeProfiles(srcSP pprofile.ScopeProfiles, count int) pprofile.ScopeProfiles {
+	destSP := pprofile.NewScopeProfiles()
+	destSP.SetSchemaUrl(srcSP.SchemaUrl())
+	srcSP.Scope().CopyTo(destSP.Scope())
+	srcSP.Profiles().RemoveIf(func(srcPC pprofile.ProfileContainer) bool {
+		if count == 0 {
+			return false
+		}
+		needToExtract := srcPC.Profile().Sample().Len() > count
+		if needToExtract {
+			srcPC = extractProfileContainer(srcPC, count)
+		}
+		count -= srcPC.Profile().Sample().Len()
+		srcPC.MoveTo(destSP.Profiles().AppendEmpty())
+		return !needToExtract
+	})
+	return destSP
+}
+
+// extractProfileContainer extracts samples and returns a new profile container with the specified number of samples.
+// Samples refer to the lookup tables of their profile by index, so the tables are copied to the new profile as is.
+func extractProfileContainer(srcPC pprofile.ProfileContainer, count int) pprofile.ProfileContainer {
+	destPC := pprofile.NewProfileContainer()
+	samples := pprofile.NewSampleSlice()
+	srcPC.Profile().Sample().MoveAndAppendTo(samples)
+	srcPC.CopyTo(destPC)
+	samples.MoveAndAppendTo(srcPC.Profile().Sample())
+	srcPC.Profile().Sample().RemoveIf(func(srcSample pprofile.Sample) bool {
+		if count == 0 {
+			return false
+		}
+		srcSample.MoveTo(destPC.Profile().Sample().AppendEmpty())
+		count--
+		return true
+	})
+	return destPC
+}
+
+// resourceProfilesCount calculates the total number of samples in the pprofile.ResourceProfiles.
+func resourceProfilesCount(rp pprofile.ResourceProfiles) int {
+	count := 0
+	for i := 0; i < rp.ScopeProfiles().Len(); i++ {
+		count += scopeProfilesCount(rp.ScopeProfiles().At(i))
+	}
+	return count
+}
+
+// scopeProfilesCount calculates the total number of samples in the pprofile.ScopeProfiles.
+func scopeProfilesCount(sp pprofile.ScopeProfiles) int {
+	count := 0
+	for i := 0; i < sp.Profiles().Len(); i++ {
+		count += sp.Profiles().At(i).Profile().Sample().Len()
+	}
+	return count
+}
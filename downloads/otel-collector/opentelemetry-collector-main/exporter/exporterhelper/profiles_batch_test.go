@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/exporter/exporterbatcher"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestMergeProfiles(t *testing.T) {
+	pr1 := &profilesRequest{pd: testdata.GenerateProfiles(2)}
+	pr2 := &profilesRequest{pd: testdata.GenerateProfiles(3)}
+	res, err := mergeProfiles(context.Background(), pr1, pr2)
+	require.NoError(t, err)
+	assert.Equal(t, 5, res.(*profilesRequest).pd.SampleCount())
+}
+
+func TestMergeProfilesInvalidInput(t *testing.T) {
+	pr1 := &tracesRequest{td: testdata.GenerateTraces(2)}
+	pr2 := &profilesRequest{pd: testdata.GenerateProfiles(3)}
+	_, err := mergeProfiles(context.Background(), pr1, pr2)
+	assert.Error(t, err)
+}
+
+func TestMergeSplitProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      exporterbatcher.MaxSizeConfig
+		pr1      Request
+		pr2      Request
+		expected []*profilesRequest
+	}{
+		{
+			name:     "both_requests_empty",
+			cfg:      exporterbatcher.MaxSizeConfig{MaxSizeItems: 10},
+			pr1:      &profilesRequest{pd: pprofile.NewProfiles()},
+			pr2:      &profilesRequest{pd: pprofile.NewProfiles()},
+			expected: []*profilesRequest{{pd: pprofile.NewProfiles()}},
+		},
+		{
+			name:     "both_requests_nil",
+			cfg:      exporterbatcher.MaxSizeConfig{MaxSizeItems: 10},
+			pr1:      nil,
+			pr2:      nil,
+			expected: []*profilesRequest{},
+		},
+		{
+			name:     "first_request_empty",
+			cfg:      exporterbatcher.MaxSizeConfig{MaxSizeItems: 10},
+			pr1:      &profilesRequest{pd: pprofile.NewProfiles()},
+			pr2:      &profilesRequest{pd: testdata.GenerateProfiles(5)},
+			expected: []*profilesRequest{{pd: testdata.GenerateProfiles(5)}},
+		},
+		{
+			name: "merge_only",
+			cfg:  exporterbatcher.MaxSizeConfig{MaxSizeItems: 10},
+			pr1:  &profilesRequest{pd: testdata.GenerateProfiles(4)},
+			pr2:  &profilesRequest{pd: testdata.GenerateProfiles(6)},
+			expected: []*profilesRequest{{pd: func() pprofile.Profiles {
+				pd := testdata.GenerateProfiles(4)
+				testdata.GenerateProfiles(6).ResourceProfiles().MoveAndAppendTo(pd.ResourceProfiles())
+				return pd
+			}()}},
+		},
+		{
+			name: "split_only",
+			cfg:  exporterbatcher.MaxSizeConfig{MaxSizeItems: 4},
+			pr1:  nil,
+			pr2:  &profilesRequest{pd: testdata.GenerateProfiles(10)},
+			expected: []*profilesRequest{
+				{pd: testdata.GenerateProfiles(4)},
+				{pd: testdata.GenerateProfiles(4)},
+				{pd: testdata.GenerateProfiles(2)},
+			},
+		},
+		{
+			name: "merge_and_split",
+			cfg:  exporterbatcher.MaxSizeConfig{MaxSizeItems: 10},
+			pr1:  &profilesRequest{pd: testdata.GenerateProfiles(8)},
+			pr2:  &profilesRequest{pd: testdata.GenerateProfiles(20)},
+			expected: []*profilesRequest{
+				{pd: func() pprofile.Profiles {
+					pd := testdata.GenerateProfiles(8)
+					testdata.GenerateProfiles(2).ResourceProfiles().MoveAndAppendTo(pd.ResourceProfiles())
+					return pd
+				}()},
+				{pd: testdata.GenerateProfiles(10)},
+				{pd: testdata.GenerateProfiles(8)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := mergeSplitProfiles(context.Background(), tt.cfg, tt.pr1, tt.pr2)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.expected), len(res))
+			for i, r := range res {
+				assert.Equal(t, tt.expected[i].pd.SampleCount(), r.(*profilesRequest).pd.SampleCount())
+			}
+		})
+	}
+}
+
+func TestMergeSplitProfilesInvalidInput(t *testing.T) {
+	r1 := &tracesRequest{td: testdata.GenerateTraces(2)}
+	r2 := &profilesRequest{pd: testdata.GenerateProfiles(3)}
+	_, err := mergeSplitProfiles(context.Background(), exporterbatcher.MaxSizeConfig{}, r1, r2)
+	assert.Error(t, err)
+}
+
+func TestExtractProfiles(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		pd := testdata.GenerateProfiles(10)
+		extractedProfiles := extractProfiles(pd, i)
+		assert.Equal(t, i, extractedProfiles.SampleCount())
+		assert.Equal(t, 10-i, pd.SampleCount())
+	}
+}
+
+func TestExtractProfileContainerKeepsLookupTables(t *testing.T) {
+	pc := pprofile.NewProfileContainer()
+	pc.SetProfileID([16]byte{1})
+	pc.Profile().StringTable().Append("", "cpu")
+	pc.Profile().Function().AppendEmpty().SetName(1)
+	for i := 0; i < 3; i++ {
+		pc.Profile().Sample().AppendEmpty().Value().Append(int64(i))
+	}
+
+	extracted := extractProfileContainer(pc, 2)
+	assert.Equal(t, 2, extracted.Profile().Sample().Len())
+	assert.Equal(t, 1, pc.Profile().Sample().Len())
+	assert.Equal(t, pc.ProfileID(), extracted.ProfileID())
+	assert.Equal(t, []string{"", "cpu"}, extracted.Profile().StringTable().AsRaw())
+	assert.Equal(t, 1, extracted.Profile().Function().Len())
+	assert.Equal(t, int64(2), pc.Profile().Sample().At(0).Value().At(0))
+}
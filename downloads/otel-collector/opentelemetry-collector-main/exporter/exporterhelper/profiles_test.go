@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterbatcher"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+var (
+	fakeProfilesExporterName   = component.MustNewIDWithName("fake_profiles_exporter", "with_name")
+	fakeProfilesExporterConfig = struct{}{}
+)
+
+func TestProfilesRequest(t *testing.T) {
+	pr := newProfilesRequest(testdata.GenerateProfiles(2), nil)
+	assert.Equal(t, 2, pr.ItemsCount())
+	buf, err := profilesRequestMarshaler(pr)
+	require.NoError(t, err)
+	assert.Len(t, buf, pr.(*profilesRequest).BytesSize())
+
+	unmarshaled, err := newProfilesRequestUnmarshalerFunc(nil)(buf)
+	require.NoError(t, err)
+	assert.Equal(t, testdata.GenerateProfiles(2), unmarshaled.(*profilesRequest).pd)
+}
+
+func TestProfilesExporter_InvalidName(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), nil, newProfilesDataPusher(nil))
+	require.Nil(t, pe)
+	require.Equal(t, errNilConfig, err)
+}
+
+func TestProfilesExporter_NilLogger(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exporter.Settings{}, &fakeProfilesExporterConfig, newProfilesDataPusher(nil))
+	require.Nil(t, pe)
+	require.Equal(t, errNilLogger, err)
+}
+
+func TestProfilesExporter_NilPushProfilesData(t *testing.T) {
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, nil)
+	require.Nil(t, pe)
+	require.Equal(t, errNilPushProfilesData, err)
+}
+
+func TestProfilesRequestExporter_NilProfilesConverter(t *testing.T) {
+	pe, err := NewProfilesRequestExporter(context.Background(), exportertest.NewNopSettings(), nil)
+	require.Nil(t, pe)
+	require.Equal(t, errNilProfilesConverter, err)
+}
+
+func TestProfilesExporter_Default(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, newProfilesDataPusher(nil))
+	assert.NotNil(t, pe)
+	require.NoError(t, err)
+
+	assert.Equal(t, consumer.Capabilities{MutatesData: false}, pe.Capabilities())
+	assert.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pe.ConsumeProfiles(context.Background(), pd))
+	assert.NoError(t, pe.Shutdown(context.Background()))
+}
+
+func TestProfilesExporter_Default_ReturnError(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	want := errors.New("my_error")
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, newProfilesDataPusher(want))
+	require.NoError(t, err)
+	require.NotNil(t, pe)
+	require.Equal(t, want, pe.ConsumeProfiles(context.Background(), pd))
+}
+
+func TestProfilesRequestExporter_Default_ConvertError(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	want := errors.New("convert_error")
+	set := exportertest.NewNopSettings()
+	logger, observed := observer.New(zap.ErrorLevel)
+	set.Logger = zap.New(logger)
+	pe, err := NewProfilesRequestExporter(context.Background(), set,
+		(&fakeRequestConverter{profilesError: want}).requestFromProfilesFunc)
+	require.NoError(t, err)
+	require.NotNil(t, pe)
+	require.Equal(t, consumererror.NewPermanent(want), pe.ConsumeProfiles(context.Background(), pd))
+	require.Len(t, observed.All(), 1)
+	assert.Equal(t, want.Error(), observed.All()[0].ContextMap()["error"])
+}
+
+func TestProfilesExporter_WithPersistentQueue(t *testing.T) {
+	qCfg := NewDefaultQueueConfig()
+	storageID := component.MustNewIDWithName("file_storage", "storage")
+	qCfg.StorageID = &storageID
+	rCfg := configretry.NewDefaultBackOffConfig()
+	ps := consumertest.ProfilesSink{}
+	set := exportertest.NewNopSettings()
+	set.ID = component.MustNewIDWithName("test_profiles", "with_persistent_queue")
+	pe, err := NewProfilesExporter(context.Background(), set, &fakeProfilesExporterConfig, ps.ConsumeProfiles, WithRetry(rCfg), WithQueue(qCfg))
+	require.NoError(t, err)
+
+	host := &mockHost{ext: map[component.ID]component.Component{
+		storageID: queue.NewMockStorageExtension(nil),
+	}}
+	require.NoError(t, pe.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, pe.Shutdown(context.Background())) })
+
+	require.NoError(t, pe.ConsumeProfiles(context.Background(), testdata.GenerateProfiles(2)))
+	require.Eventually(t, func() bool {
+		return len(ps.AllProfiles()) == 1 && ps.AllProfiles()[0].SampleCount() == 2
+	}, 500*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestProfilesExporter_WithBatcher(t *testing.T) {
+	ps := consumertest.ProfilesSink{}
+	bCfg := exporterbatcher.NewDefaultConfig()
+	bCfg.MinSizeItems = 4
+	bCfg.MaxSizeItems = 4
+	bCfg.FlushTimeout = time.Hour
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, ps.ConsumeProfiles,
+		WithBatcher(bCfg))
+	require.NoError(t, err)
+	require.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, pe.Shutdown(context.Background())) })
+
+	require.NoError(t, pe.ConsumeProfiles(context.Background(), testdata.GenerateProfiles(5)))
+	// The request is split by the max size and all the parts are sent right away.
+	require.Len(t, ps.AllProfiles(), 2)
+	assert.Equal(t, 4, ps.AllProfiles()[0].SampleCount())
+	assert.Equal(t, 1, ps.AllProfiles()[1].SampleCount())
+}
+
+func TestProfilesExporter_WithBatcherMaxSizeBytes(t *testing.T) {
+	bCfg := exporterbatcher.NewDefaultConfig()
+	bCfg.MaxSizeBytes = 1000
+	_, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, newProfilesDataPusher(nil),
+		WithBatcher(bCfg))
+	require.ErrorIs(t, err, errProfilesMaxSizeBytes)
+
+	// The size of the batches in bytes is only used to flush them.
+	bCfg.MaxSizeBytes = 0
+	bCfg.MinSizeBytes = 1000
+	_, err = NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, newProfilesDataPusher(nil),
+		WithBatcher(bCfg))
+	require.NoError(t, err)
+}
+
+func TestProfilesExporter_WithSpan(t *testing.T) {
+	set := exportertest.NewNopSettings()
+	sr := new(tracetest.SpanRecorder)
+	set.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	otel.SetTracerProvider(set.TracerProvider)
+	defer otel.SetTracerProvider(nooptrace.NewTracerProvider())
+
+	want := errors.New("my_error")
+	pe, err := NewProfilesExporter(context.Background(), set, &fakeProfilesExporterConfig, newProfilesDataPusher(want))
+	require.NoError(t, err)
+	require.NotNil(t, pe)
+
+	pd := testdata.GenerateProfiles(3)
+	require.Equal(t, want, pe.ConsumeProfiles(context.Background(), pd))
+
+	gotSpanData := sr.Ended()
+	require.Len(t, gotSpanData, 1)
+	checkStatus(t, gotSpanData[0], want)
+	require.Contains(t, gotSpanData[0].Attributes(), attribute.KeyValue{Key: internal.SentSamplesKey, Value: attribute.Int64Value(0)})
+	require.Contains(t, gotSpanData[0].Attributes(), attribute.KeyValue{Key: internal.FailedToSendSamplesKey, Value: attribute.Int64Value(3)})
+}
+
+func TestProfilesExporter_WithShutdown(t *testing.T) {
+	shutdownCalled := false
+	shutdown := func(context.Context) error { shutdownCalled = true; return nil }
+
+	pe, err := NewProfilesExporter(context.Background(), exportertest.NewNopSettings(), &fakeProfilesExporterConfig, newProfilesDataPusher(nil), WithShutdown(shutdown))
+	assert.NotNil(t, pe)
+	assert.NoError(t, err)
+
+	assert.NoError(t, pe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pe.Shutdown(context.Background()))
+	assert.True(t, shutdownCalled)
+}
+
+func newProfilesDataPusher(retError error) consumerprofiles.ConsumeProfilesFunc {
+	return func(context.Context, pprofile.Profiles) error {
+		return retError
+	}
+}
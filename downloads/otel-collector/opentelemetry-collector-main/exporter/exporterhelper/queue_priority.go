@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporterhelper"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/exporter/exporterqueue"
+	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// PriorityConfig defines configuration for routing the queued requests into several priority lanes.
+// Consumers drain the higher priority lanes first.
+type PriorityConfig struct {
+	// MetadataKey is the client.Metadata key whose value selects the lane of a request.
+	MetadataKey string `mapstructure:"metadata_key"`
+	// ResourceAttribute is the resource attribute whose value selects the lane of a request.
+	// A request containing several resources goes to the highest priority lane matched by any of them.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
+	// Lanes are ordered from the highest to the lowest priority.
+	// The requests that don't match any lane go to the last one.
+	Lanes []PriorityLaneConfig `mapstructure:"lanes"`
+}
+
+// PriorityLaneConfig defines configuration for a single priority lane.
+type PriorityLaneConfig struct {
+	// Name identifies the lane. It's used to name the storage of the lane if the queue is persistent.
+	Name string `mapstructure:"name"`
+	// Values are the values of the metadata key or resource attribute routed to this lane.
+	Values []string `mapstructure:"values"`
+	// QueueSize is the maximum size of the lane, measured in units of the queue Sizer.
+	QueueSize int `mapstructure:"queue_size"`
+}
+
+// Validate checks if the PriorityConfig configuration is valid
+func (cfg *PriorityConfig) Validate() error {
+	if len(cfg.Lanes) == 0 {
+		return nil
+	}
+	if (cfg.MetadataKey == "") == (cfg.ResourceAttribute == "") {
+		return errors.New("exactly one of metadata_key and resource_attribute must be set when priority lanes are configured")
+	}
+	names := make(map[string]struct{}, len(cfg.Lanes))
+	for _, lane := range cfg.Lanes {
+		if lane.Name == "" {
+			return errors.New("priority lane name must not be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("duplicate priority lane name %q", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+		if lane.QueueSize <= 0 {
+			return fmt.Errorf("queue size of priority lane %q must be positive", lane.Name)
+		}
+	}
+	return nil
+}
+
+// resourcesRequest is implemented by the requests of this package to expose the resources of their data.
+type resourcesRequest interface {
+	resources() []pcommon.Resource
+}
+
+// laneFunc returns the function selecting the lane of a request.
+func (cfg *PriorityConfig) laneFunc() func(context.Context, Request) int {
+	lastLane := len(cfg.Lanes) - 1
+	laneOf := func(values []string) int {
+		for i, lane := range cfg.Lanes[:lastLane] {
+			for _, v := range values {
+				if slices.Contains(lane.Values, v) {
+					return i
+				}
+			}
+		}
+		return lastLane
+	}
+
+	if cfg.MetadataKey != "" {
+		return func(ctx context.Context, _ Request) int {
+			return laneOf(client.FromContext(ctx).Metadata.Get(cfg.MetadataKey))
+		}
+	}
+	return func(_ context.Context, req Request) int {
+		rr, ok := req.(resourcesRequest)
+		if !ok {
+			return lastLane
+		}
+		var values []string
+		for _, res := range rr.resources() {
+			if v, found := res.Attributes().Get(cfg.ResourceAttribute); found {
+				values = append(values, v.AsString())
+			}
+		}
+		return laneOf(values)
+	}
+}
+
+// newPriorityQueue creates a queue with one lane per configured priority lane. Every lane is persisted
+// with its own storage client if the queue is persistent.
+func newPriorityQueue(config QueueConfig, set exporterqueue.Settings,
+	marshaler exporterqueue.Marshaler[Request], unmarshaler exporterqueue.Unmarshaler[Request]) exporterqueue.Queue[Request] {
+	lanes := make([]queue.Queue[Request], 0, len(config.Priority.Lanes))
+	for _, lane := range config.Priority.Lanes {
+		qf := exporterqueue.NewPersistentQueueFactory[Request](config.StorageID, exporterqueue.PersistentQueueSettings[Request]{
+			Marshaler:   marshaler,
+			Unmarshaler: unmarshaler,
+			StorageName: set.DataType.String() + "_" + lane.Name,
+		})
+		lanes = append(lanes, qf(context.Background(), set, exporterqueue.Config{
+			Enabled:      config.Enabled,
+			NumConsumers: config.NumConsumers,
+			Sizer:        config.Sizer,
+			QueueSize:    lane.QueueSize,
+		}))
+	}
+	return queue.NewPriorityQueue[Request](queue.PriorityQueueSettings[Request]{
+		Lanes:    lanes,
+		LaneFunc: config.Priority.laneFunc(),
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporterhelper
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newTestPriorityConfig() PriorityConfig {
+	return PriorityConfig{
+		MetadataKey: "tenant",
+		Lanes: []PriorityLaneConfig{
+			{Name: "critical", Values: []string{"gold"}, QueueSize: 10},
+			{Name: "bulk", QueueSize: 10},
+		},
+	}
+}
+
+func TestPriorityConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*PriorityConfig)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:   "no_lanes",
+			modify: func(cfg *PriorityConfig) { *cfg = PriorityConfig{} },
+		},
+		{
+			name:    "no_key",
+			modify:  func(cfg *PriorityConfig) { cfg.MetadataKey = "" },
+			wantErr: "exactly one of metadata_key and resource_attribute must be set when priority lanes are configured",
+		},
+		{
+			name:    "both_keys",
+			modify:  func(cfg *PriorityConfig) { cfg.ResourceAttribute = "service.tier" },
+			wantErr: "exactly one of metadata_key and resource_attribute must be set when priority lanes are configured",
+		},
+		{
+			name:    "empty_name",
+			modify:  func(cfg *PriorityConfig) { cfg.Lanes[0].Name = "" },
+			wantErr: "priority lane name must not be empty",
+		},
+		{
+			name:    "duplicate_name",
+			modify:  func(cfg *PriorityConfig) { cfg.Lanes[1].Name = "critical" },
+			wantErr: `duplicate priority lane name "critical"`,
+		},
+		{
+			name:    "invalid_queue_size",
+			modify:  func(cfg *PriorityConfig) { cfg.Lanes[1].QueueSize = 0 },
+			wantErr: `queue size of priority lane "bulk" must be positive`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestPriorityConfig()
+			if tt.modify != nil {
+				tt.modify(&cfg)
+			}
+			if tt.wantErr == "" {
+				assert.NoError(t, cfg.Validate())
+				return
+			}
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestPriorityConfig_LaneByMetadata(t *testing.T) {
+	cfg := newTestPriorityConfig()
+	laneFunc := cfg.laneFunc()
+	req := newLogsRequest(plog.NewLogs(), nil)
+
+	ctxWith := func(values ...string) context.Context {
+		return client.NewContext(context.Background(), client.Info{
+			Metadata: client.NewMetadata(map[string][]string{"tenant": values}),
+		})
+	}
+	assert.Equal(t, 0, laneFunc(ctxWith("gold"), req))
+	assert.Equal(t, 0, laneFunc(ctxWith("silver", "gold"), req))
+	assert.Equal(t, 1, laneFunc(ctxWith("silver"), req))
+	assert.Equal(t, 1, laneFunc(context.Background(), req))
+}
+
+func TestPriorityConfig_LaneByResourceAttribute(t *testing.T) {
+	cfg := newTestPriorityConfig()
+	cfg.MetadataKey = ""
+	cfg.ResourceAttribute = "service.tier"
+	laneFunc := cfg.laneFunc()
+
+	newRequest := func(tiers ...string) Request {
+		ld := plog.NewLogs()
+		for _, tier := range tiers {
+			ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("service.tier", tier)
+		}
+		ld.ResourceLogs().AppendEmpty()
+		return newLogsRequest(ld, nil)
+	}
+	assert.Equal(t, 0, laneFunc(context.Background(), newRequest("gold")))
+	assert.Equal(t, 0, laneFunc(context.Background(), newRequest("silver", "gold")))
+	assert.Equal(t, 1, laneFunc(context.Background(), newRequest("silver")))
+	assert.Equal(t, 1, laneFunc(context.Background(), newRequest()))
+}
+
+func TestQueuedRetry_PriorityLanes(t *testing.T) {
+	qCfg := NewDefaultQueueConfig()
+	qCfg.NumConsumers = 1
+	qCfg.Priority = newTestPriorityConfig()
+	qCfg.Priority.MetadataKey = ""
+	qCfg.Priority.ResourceAttribute = "tenant"
+
+	// The first request blocks the only consumer until the other requests are queued.
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var exported []string
+	push := func(_ context.Context, ld plog.Logs) error {
+		tenant, _ := ld.ResourceLogs().At(0).Resource().Attributes().Get("tenant")
+		mu.Lock()
+		exported = append(exported, tenant.Str())
+		first := len(exported) == 1
+		mu.Unlock()
+		if first {
+			close(blocked)
+			<-release
+		}
+		return nil
+	}
+	le, err := NewLogsExporter(context.Background(), exportertest.NewNopSettings(), &fakeLogsExporterConfig, push, WithQueue(qCfg))
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), &mockHost{ext: map[component.ID]component.Component{}}))
+
+	newLogs := func(tenant string) plog.Logs {
+		ld := plog.NewLogs()
+		ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant", tenant)
+		return ld
+	}
+	require.NoError(t, le.ConsumeLogs(context.Background(), newLogs("first")))
+	<-blocked
+	for _, tenant := range []string{"silver", "bronze", "gold"} {
+		require.NoError(t, le.ConsumeLogs(context.Background(), newLogs(tenant)))
+	}
+	close(release)
+	require.NoError(t, le.Shutdown(context.Background()))
+
+	assert.Equal(t, []string{"first", "gold", "silver", "bronze"}, exported)
+}
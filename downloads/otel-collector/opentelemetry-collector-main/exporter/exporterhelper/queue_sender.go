@@ -6,6 +6,7 @@ package exporterhelper // import "go.opentelemetry.io/collector/exporter/exporte
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -33,11 +34,19 @@ type QueueConfig struct {
 	// If batching is enabled, a combined batch cannot contain more requests than the number of consumers.
 	// So it's recommended to set higher number of consumers if batching is enabled.
 	NumConsumers int `mapstructure:"num_consumers"`
-	// QueueSize is the maximum number of batches allowed in queue at a given time.
+	// Sizer determines the unit QueueSize is measured in: "requests" (default), "items" or "bytes".
+	Sizer exporterqueue.SizerType `mapstructure:"sizer"`
+	// QueueSize is the maximum size of the queue at a given time, measured in units of the Sizer.
 	QueueSize int `mapstructure:"queue_size"`
 	// StorageID if not empty, enables the persistent storage and uses the component specified
 	// as a storage extension for the persistent queue
 	StorageID *component.ID `mapstructure:"storage"`
+	// Priority if lanes are set, routes the requests into several lanes drained from the highest priority.
+	// The QueueSize is ignored in that case, each lane has its own size.
+	Priority PriorityConfig `mapstructure:"priority"`
+	// AdaptiveConcurrency if enabled, adapts the number of consumers exporting at the same time,
+	// up to NumConsumers, to the observed export latency and throttling.
+	AdaptiveConcurrency AdaptiveConcurrencyConfig `mapstructure:"adaptive_concurrency"`
 }
 
 // Deprecated: [v0.110.0] Use NewDefaultQueueConfig instead.
@@ -50,6 +59,7 @@ func NewDefaultQueueConfig() QueueConfig {
 	return QueueConfig{
 		Enabled:      true,
 		NumConsumers: 10,
+		Sizer:        exporterqueue.SizerTypeRequests,
 		// By default, batches are 8192 spans, for a total of up to 8 million spans in the queue
 		// This can be estimated at 1-4 GB worth of maximum memory usage
 		// This default is probably still too high, and may be adjusted further down in a future release
@@ -63,7 +73,7 @@ func (qCfg *QueueConfig) Validate() error {
 		return nil
 	}
 
-	if qCfg.QueueSize <= 0 {
+	if qCfg.QueueSize <= 0 && len(qCfg.Priority.Lanes) == 0 {
 		return errors.New("queue size must be positive")
 	}
 
@@ -71,6 +81,16 @@ func (qCfg *QueueConfig) Validate() error {
 		return errors.New("number of queue consumers must be positive")
 	}
 
+	switch qCfg.Sizer {
+	case "", exporterqueue.SizerTypeRequests, exporterqueue.SizerTypeItems, exporterqueue.SizerTypeBytes:
+	default:
+		return fmt.Errorf("unsupported sizer type %q", qCfg.Sizer)
+	}
+
+	if qCfg.AdaptiveConcurrency.Enabled && qCfg.AdaptiveConcurrency.MinConsumers > qCfg.NumConsumers {
+		return errors.New("adaptive concurrency min_consumers must not be greater than the number of queue consumers")
+	}
+
 	return nil
 }
 
@@ -80,19 +100,22 @@ type queueSender struct {
 	numConsumers   int
 	traceAttribute attribute.KeyValue
 	consumers      *queue.Consumers[Request]
+	// concurrency is nil if the number of consumers exporting at the same time is fixed.
+	concurrency *concurrencyController
 
 	obsrep     *obsReport
 	exporterID component.ID
 }
 
 func newQueueSender(q exporterqueue.Queue[Request], set exporter.Settings, numConsumers int,
-	exportFailureMessage string, obsrep *obsReport) *queueSender {
+	exportFailureMessage string, obsrep *obsReport, concurrency *concurrencyController) *queueSender {
 	qs := &queueSender{
 		queue:          q,
 		numConsumers:   numConsumers,
 		traceAttribute: attribute.String(internal.ExporterKey, set.ID.String()),
 		obsrep:         obsrep,
 		exporterID:     set.ID,
+		concurrency:    concurrency,
 	}
 	consumeFunc := func(ctx context.Context, req Request) error {
 		err := qs.nextSender.send(ctx, req)
@@ -102,7 +125,11 @@ func newQueueSender(q exporterqueue.Queue[Request], set exporter.Settings, numCo
 		}
 		return err
 	}
-	qs.consumers = queue.NewQueueConsumers[Request](q, numConsumers, consumeFunc)
+	if concurrency != nil {
+		qs.consumers = queue.NewQueueConsumersWithLimiter[Request](q, numConsumers, consumeFunc, concurrency)
+	} else {
+		qs.consumers = queue.NewQueueConsumers[Request](q, numConsumers, consumeFunc)
+	}
 	return qs
 }
 
@@ -113,18 +140,32 @@ func (qs *queueSender) Start(ctx context.Context, host component.Host) error {
 	}
 
 	dataTypeAttr := attribute.String(internal.DataTypeKey, qs.obsrep.dataType.String())
-	return multierr.Append(
+	return multierr.Combine(
 		qs.obsrep.telemetryBuilder.InitExporterQueueSize(func() int64 { return int64(qs.queue.Size()) },
 			metric.WithAttributeSet(attribute.NewSet(qs.traceAttribute, dataTypeAttr))),
 		qs.obsrep.telemetryBuilder.InitExporterQueueCapacity(func() int64 { return int64(qs.queue.Capacity()) },
 			metric.WithAttributeSet(attribute.NewSet(qs.traceAttribute))),
+		qs.obsrep.telemetryBuilder.InitExporterQueueConcurrency(func() int64 { return int64(qs.concurrencyLimit()) },
+			metric.WithAttributeSet(attribute.NewSet(qs.traceAttribute, dataTypeAttr))),
 	)
 }
 
+// concurrencyLimit returns the number of consumers allowed to export at the same time.
+func (qs *queueSender) concurrencyLimit() int {
+	if qs.concurrency == nil {
+		return qs.numConsumers
+	}
+	return qs.concurrency.concurrency()
+}
+
 // Shutdown is invoked during service shutdown.
 func (qs *queueSender) Shutdown(ctx context.Context) error {
 	// Stop the queue and consumers, this will drain the queue and will call the retry (which is stopped) that will only
 	// try once every request.
+	if qs.concurrency != nil {
+		// Let all the consumers drain the queue.
+		qs.concurrency.stop()
+	}
 	return qs.consumers.Shutdown(ctx)
 }
 
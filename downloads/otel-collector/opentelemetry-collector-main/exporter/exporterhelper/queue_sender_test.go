@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/testdata"
 )
 
 func TestQueuedRetry_StopWhileWaiting(t *testing.T) {
@@ -232,6 +234,28 @@ func TestQueuedRetry_QueueMetricsReported(t *testing.T) {
 	}
 }
 
+func TestQueuedRetry_QueueSizeReportedInBytes(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(defaultID)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+	qCfg := NewDefaultQueueConfig()
+	qCfg.NumConsumers = 0 // to make every request go straight to the queue
+	qCfg.Sizer = exporterqueue.SizerTypeBytes
+	set := exporter.Settings{ID: defaultID, TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}
+	te, err := NewTracesExporter(context.Background(), set, &fakeTracesExporterConfig, newTraceDataPusher(nil), WithQueue(qCfg))
+	require.NoError(t, err)
+	require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := testdata.GenerateTraces(2)
+	size := (&ptrace.ProtoMarshaler{}).TracesSize(td)
+	require.NoError(t, te.ConsumeTraces(context.Background(), td))
+	require.NoError(t, tt.CheckExporterMetricGauge("otelcol_exporter_queue_size", int64(size),
+		attribute.String(internal.DataTypeKey, component.DataTypeTraces.String())))
+
+	assert.NoError(t, te.Shutdown(context.Background()))
+}
+
 func TestNoCancellationContext(t *testing.T) {
 	deadline := time.Now().Add(1 * time.Second)
 	ctx, cancelFunc := context.WithDeadline(context.Background(), deadline)
@@ -255,11 +279,19 @@ func TestQueueConfig_Validate(t *testing.T) {
 	qCfg.QueueSize = 0
 	require.EqualError(t, qCfg.Validate(), "queue size must be positive")
 
+	// Every priority lane has its own size.
+	qCfg.Priority = PriorityConfig{MetadataKey: "tenant", Lanes: []PriorityLaneConfig{{Name: "default", QueueSize: 10}}}
+	require.NoError(t, qCfg.Validate())
+
 	qCfg = NewDefaultQueueConfig()
 	qCfg.NumConsumers = 0
 
 	require.EqualError(t, qCfg.Validate(), "number of queue consumers must be positive")
 
+	qCfg = NewDefaultQueueConfig()
+	qCfg.Sizer = "invalid"
+	require.EqualError(t, qCfg.Validate(), `unsupported sizer type "invalid"`)
+
 	// Confirm Validate doesn't return error with invalid config when feature is disabled
 	qCfg.Enabled = false
 	assert.NoError(t, qCfg.Validate())
@@ -437,7 +469,7 @@ func TestQueueSenderNoStartShutdown(t *testing.T) {
 		exporterCreateSettings: exportertest.NewNopSettings(),
 	})
 	require.NoError(t, err)
-	qs := newQueueSender(queue, set, 1, "", obsrep)
+	qs := newQueueSender(queue, set, 1, "", obsrep, nil)
 	assert.NoError(t, qs.Shutdown(context.Background()))
 }
 
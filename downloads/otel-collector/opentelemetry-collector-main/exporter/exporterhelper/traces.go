@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterqueue"
 	"go.opentelemetry.io/collector/exporter/internal/queue"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -63,6 +64,20 @@ func (req *tracesRequest) ItemsCount() int {
 	return req.td.SpanCount()
 }
 
+func (req *tracesRequest) resources() []pcommon.Resource {
+	rs := req.td.ResourceSpans()
+	res := make([]pcommon.Resource, 0, rs.Len())
+	for i := 0; i < rs.Len(); i++ {
+		res = append(res, rs.At(i).Resource())
+	}
+	return res
+}
+
+// BytesSize returns the size of the request once marshaled to OTLP protobuf.
+func (req *tracesRequest) BytesSize() int {
+	return tracesMarshaler.TracesSize(req.td)
+}
+
 type traceExporter struct {
 	*baseExporter
 	consumer.Traces
@@ -27,7 +27,8 @@ func mergeSplitTraces(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 R
 	var (
 		res          []Request
 		destReq      *tracesRequest
-		capacityLeft = cfg.MaxSizeItems
+		sz, capacity = newTracesSizer(cfg)
+		capacityLeft = capacity
 	)
 	for _, req := range []Request{r1, r2} {
 		if req == nil {
@@ -37,32 +38,43 @@ func mergeSplitTraces(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 R
 		if !ok {
 			return nil, errors.New("invalid input type")
 		}
-		if srcReq.td.SpanCount() <= capacityLeft {
+		if srcSize := sz.tracesSize(srcReq.td); srcSize <= capacityLeft {
 			if destReq == nil {
 				destReq = srcReq
 			} else {
 				srcReq.td.ResourceSpans().MoveAndAppendTo(destReq.td.ResourceSpans())
 			}
-			capacityLeft -= destReq.td.SpanCount()
+			capacityLeft -= srcSize
 			continue
 		}
 
 		for {
-			extractedTraces := extractTraces(srcReq.td, capacityLeft)
+			extractedTraces := extractTraces(srcReq.td, capacityLeft, sz)
 			if extractedTraces.SpanCount() == 0 {
-				break
+				if srcReq.td.SpanCount() == 0 {
+					break
+				}
+				// Nothing fits in the remaining capacity, create a new batch.
+				if destReq != nil {
+					res = append(res, destReq)
+					destReq = nil
+					capacityLeft = capacity
+					continue
+				}
+				// A single span is bigger than the maximum size, send it on its own.
+				extractedTraces = extractTraces(srcReq.td, 1, tracesItemsSizer{})
 			}
-			capacityLeft -= extractedTraces.SpanCount()
+			capacityLeft -= sz.tracesSize(extractedTraces)
 			if destReq == nil {
 				destReq = &tracesRequest{td: extractedTraces, pusher: srcReq.pusher}
 			} else {
 				extractedTraces.ResourceSpans().MoveAndAppendTo(destReq.td.ResourceSpans())
 			}
 			// Create new batch once capacity is reached.
-			if capacityLeft == 0 {
+			if capacityLeft <= 0 {
 				res = append(res, destReq)
 				destReq = nil
-				capacityLeft = cfg.MaxSizeItems
+				capacityLeft = capacity
 			}
 		}
 	}
@@ -73,56 +85,131 @@ func mergeSplitTraces(_ context.Context, cfg exporterbatcher.MaxSizeConfig, r1 R
 	return res, nil
 }
 
-// extractTraces extracts a new traces with a maximum number of spans.
-func extractTraces(srcTraces ptrace.Traces, count int) ptrace.Traces {
+// tracesSizer computes the size of the traces and their elements, either in spans or in bytes.
+type tracesSizer interface {
+	tracesSize(td ptrace.Traces) int
+	resourceSpansSize(rs ptrace.ResourceSpans) int
+	scopeSpansSize(ss ptrace.ScopeSpans) int
+	spanSize(span ptrace.Span) int
+	deltaSize(size int) int
+	contentCapacity(capacity int) int
+}
+
+// newTracesSizer returns the sizer and the maximum size of a batch for the given configuration.
+func newTracesSizer(cfg exporterbatcher.MaxSizeConfig) (tracesSizer, int) {
+	if cfg.MaxSizeBytes > 0 {
+		return tracesBytesSizer{}, cfg.MaxSizeBytes
+	}
+	return tracesItemsSizer{}, cfg.MaxSizeItems
+}
+
+// tracesItemsSizer computes the size of the traces in spans.
+type tracesItemsSizer struct {
+	itemsSizeEncoding
+}
+
+func (tracesItemsSizer) tracesSize(td ptrace.Traces) int {
+	return td.SpanCount()
+}
+
+func (tracesItemsSizer) resourceSpansSize(rs ptrace.ResourceSpans) int {
+	return resourceTracesCount(rs)
+}
+
+func (tracesItemsSizer) scopeSpansSize(ss ptrace.ScopeSpans) int {
+	return ss.Spans().Len()
+}
+
+func (tracesItemsSizer) spanSize(ptrace.Span) int {
+	return 1
+}
+
+// tracesBytesSizer computes the size of the traces in bytes of their OTLP protobuf encoding.
+type tracesBytesSizer struct {
+	protoSizeEncoding
+}
+
+func (tracesBytesSizer) tracesSize(td ptrace.Traces) int {
+	return tracesMarshaler.TracesSize(td)
+}
+
+func (tracesBytesSizer) resourceSpansSize(rs ptrace.ResourceSpans) int {
+	return tracesMarshaler.ResourceSpansSize(rs)
+}
+
+func (tracesBytesSizer) scopeSpansSize(ss ptrace.ScopeSpans) int {
+	return tracesMarshaler.ScopeSpansSize(ss)
+}
+
+func (tracesBytesSizer) spanSize(span ptrace.Span) int {
+	return tracesMarshaler.SpanSize(span)
+}
+
+// extractTraces extracts spans from the input traces and returns new traces with a size not greater than the capacity.
+func extractTraces(srcTraces ptrace.Traces, capacity int, sz tracesSizer) ptrace.Traces {
 	destTraces := ptrace.NewTraces()
+	capacityLeft := capacity - sz.tracesSize(destTraces)
 	srcTraces.ResourceSpans().RemoveIf(func(srcRS ptrace.ResourceSpans) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := resourceTracesCount(srcRS) > count
-		if needToExtract {
-			srcRS = extractResourceSpans(srcRS, count)
+		rsSize := sz.deltaSize(sz.resourceSpansSize(srcRS))
+		if rsSize <= capacityLeft {
+			capacityLeft -= rsSize
+			srcRS.MoveTo(destTraces.ResourceSpans().AppendEmpty())
+			return true
+		}
+		if extractedRS := extractResourceSpans(srcRS, capacityLeft, sz); extractedRS.ScopeSpans().Len() > 0 {
+			extractedRS.MoveTo(destTraces.ResourceSpans().AppendEmpty())
 		}
-		count -= resourceTracesCount(srcRS)
-		srcRS.MoveTo(destTraces.ResourceSpans().AppendEmpty())
-		return !needToExtract
+		capacityLeft = 0
+		return false
 	})
 	return destTraces
 }
 
-// extractResourceSpans extracts spans and returns a new resource spans with the specified number of spans.
-func extractResourceSpans(srcRS ptrace.ResourceSpans, count int) ptrace.ResourceSpans {
+// extractResourceSpans extracts spans and returns a new resource spans with a size not greater than the capacity.
+func extractResourceSpans(srcRS ptrace.ResourceSpans, capacity int, sz tracesSizer) ptrace.ResourceSpans {
 	destRS := ptrace.NewResourceSpans()
 	destRS.SetSchemaUrl(srcRS.SchemaUrl())
 	srcRS.Resource().CopyTo(destRS.Resource())
+	capacityLeft := sz.contentCapacity(capacity) - sz.resourceSpansSize(destRS)
 	srcRS.ScopeSpans().RemoveIf(func(srcSS ptrace.ScopeSpans) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
 			return false
 		}
-		needToExtract := srcSS.Spans().Len() > count
-		if needToExtract {
-			srcSS = extractScopeSpans(srcSS, count)
+		ssSize := sz.deltaSize(sz.scopeSpansSize(srcSS))
+		if ssSize <= capacityLeft {
+			capacityLeft -= ssSize
+			srcSS.MoveTo(destRS.ScopeSpans().AppendEmpty())
+			return true
+		}
+		if extractedSS := extractScopeSpans(srcSS, capacityLeft, sz); extractedSS.Spans().Len() > 0 {
+			extractedSS.MoveTo(destRS.ScopeSpans().AppendEmpty())
 		}
-		count -= srcSS.Spans().Len()
-		srcSS.MoveTo(destRS.ScopeSpans().AppendEmpty())
-		return !needToExtract
+		capacityLeft = 0
+		return false
 	})
-	srcRS.Resource().CopyTo(destRS.Resource())
 	return destRS
 }
 
-// extractScopeSpans extracts spans and returns a new scope spans with the specified number of spans.
-func extractScopeSpans(srcSS ptrace.ScopeSpans, count int) ptrace.ScopeSpans {
+// extractScopeSpans extracts spans and returns a new scope spans with a size not greater than the capacity.
+func extractScopeSpans(srcSS ptrace.ScopeSpans, capacity int, sz tracesSizer) ptrace.ScopeSpans {
 	destSS := ptrace.NewScopeSpans()
 	destSS.SetSchemaUrl(srcSS.SchemaUrl())
 	srcSS.Scope().CopyTo(destSS.Scope())
+	capacityLeft := sz.contentCapacity(capacity) - sz.scopeSpansSize(destSS)
 	srcSS.Spans().RemoveIf(func(srcSpan ptrace.Span) bool {
-		if count == 0 {
+		if capacityLeft <= 0 {
+			return false
+		}
+		spanSize := sz.deltaSize(sz.spanSize(srcSpan))
+		if spanSize > capacityLeft {
+			capacityLeft = 0
 			return false
 		}
+		capacityLeft -= spanSize
 		srcSpan.MoveTo(destSS.Spans().AppendEmpty())
-		count--
 		return true
 	})
 	return destSS
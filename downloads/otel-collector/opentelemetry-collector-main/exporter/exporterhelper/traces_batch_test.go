@@ -153,8 +153,41 @@ func TestMergeSplitTracesInvalidInput(t *testing.T) {
 func TestExtractTraces(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		td := testdata.GenerateTraces(10)
-		extractedTraces := extractTraces(td, i)
+		extractedTraces := extractTraces(td, i, tracesItemsSizer{})
 		assert.Equal(t, i, extractedTraces.SpanCount())
 		assert.Equal(t, 10-i, td.SpanCount())
 	}
 }
+
+func TestMergeSplitTracesBytes(t *testing.T) {
+	sz := tracesBytesSizer{}
+	spanSize := sz.deltaSize(sz.spanSize(testdata.GenerateTraces(1).ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)))
+	for _, maxSize := range []int{spanSize / 2, 500, 1000, 2000, 4096} {
+		cfg := exporterbatcher.MaxSizeConfig{MaxSizeBytes: maxSize}
+		res, err := mergeSplitTraces(context.Background(), cfg, &tracesRequest{td: testdata.GenerateTraces(7)}, &tracesRequest{td: testdata.GenerateTraces(25)})
+		require.NoError(t, err)
+		count := 0
+		for _, r := range res {
+			td := r.(*tracesRequest).td
+			count += td.SpanCount()
+			if td.SpanCount() > 1 {
+				assert.LessOrEqual(t, sz.tracesSize(td), maxSize)
+			}
+		}
+		assert.Equal(t, 32, count)
+		if maxSize < spanSize {
+			// Every span is bigger than the maximum size and is sent on its own.
+			assert.Len(t, res, 32)
+		}
+	}
+}
+
+func TestExtractTracesBytes(t *testing.T) {
+	sz := tracesBytesSizer{}
+	for capacity := 0; capacity < 2000; capacity += 50 {
+		td := testdata.GenerateTraces(10)
+		extractedTraces := extractTraces(td, capacity, sz)
+		assert.LessOrEqual(t, sz.tracesSize(extractedTraces), capacity)
+		assert.Equal(t, 10, extractedTraces.SpanCount()+td.SpanCount())
+	}
+}
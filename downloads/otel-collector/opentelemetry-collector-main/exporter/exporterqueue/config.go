@@ -5,10 +5,37 @@ package exporterqueue // import "go.opentelemetry.io/collector/exporter/exporter
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 )
 
+// SizerType defines the unit used to measure the queue size and capacity.
+// Experimental: This API is at the early stage of development and may change without backward compatibility
+// until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved.
+type SizerType string
+
+const (
+	// SizerTypeRequests measures the queue in number of requests.
+	SizerTypeRequests SizerType = "requests"
+	// SizerTypeItems measures the queue in number of items (spans, metric data points or log records).
+	SizerTypeItems SizerType = "items"
+	// SizerTypeBytes measures the queue in bytes of the marshaled requests.
+	SizerTypeBytes SizerType = "bytes"
+)
+
+// UnmarshalText unmarshalls text to a SizerType.
+// Valid values are "requests", "items" and "bytes".
+func (s *SizerType) UnmarshalText(text []byte) error {
+	typ := SizerType(text)
+	switch typ {
+	case SizerTypeRequests, SizerTypeItems, SizerTypeBytes:
+		*s = typ
+		return nil
+	}
+	return fmt.Errorf("unsupported sizer type %q", typ)
+}
+
 // Config defines configuration for queueing requests before exporting.
 // It's supposed to be used with the new exporter helpers New[Traces|Metrics|Logs]RequestExporter.
 // Experimental: This API is at the early stage of development and may change without backward compatibility
@@ -18,7 +45,9 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// NumConsumers is the number of consumers from the queue.
 	NumConsumers int `mapstructure:"num_consumers"`
-	// QueueSize is the maximum number of requests allowed in queue at any given time.
+	// Sizer determines the unit QueueSize is measured in: "requests" (default), "items" or "bytes".
+	Sizer SizerType `mapstructure:"sizer"`
+	// QueueSize is the maximum size of the queue at any given time, measured in units of the Sizer.
 	QueueSize int `mapstructure:"queue_size"`
 }
 
@@ -29,6 +58,7 @@ func NewDefaultConfig() Config {
 	return Config{
 		Enabled:      true,
 		NumConsumers: 10,
+		Sizer:        SizerTypeRequests,
 		QueueSize:    1_000,
 	}
 }
@@ -44,6 +74,11 @@ func (qCfg *Config) Validate() error {
 	if qCfg.QueueSize <= 0 {
 		return errors.New("queue size must be positive")
 	}
+	switch qCfg.Sizer {
+	case "", SizerTypeRequests, SizerTypeItems, SizerTypeBytes:
+	default:
+		return fmt.Errorf("unsupported sizer type %q", qCfg.Sizer)
+	}
 	return nil
 }
 
@@ -17,6 +17,10 @@ func TestQueueConfig_Validate(t *testing.T) {
 	qCfg.NumConsumers = 0
 	require.EqualError(t, qCfg.Validate(), "number of consumers must be positive")
 
+	qCfg = NewDefaultConfig()
+	qCfg.Sizer = "invalid"
+	require.EqualError(t, qCfg.Validate(), `unsupported sizer type "invalid"`)
+
 	qCfg = NewDefaultConfig()
 	qCfg.QueueSize = 0
 	require.EqualError(t, qCfg.Validate(), "queue size must be positive")
@@ -25,3 +29,14 @@ func TestQueueConfig_Validate(t *testing.T) {
 	qCfg.Enabled = false
 	assert.NoError(t, qCfg.Validate())
 }
+
+func TestSizerType_UnmarshalText(t *testing.T) {
+	var st SizerType
+	require.NoError(t, st.UnmarshalText([]byte("bytes")))
+	assert.Equal(t, SizerTypeBytes, st)
+	require.NoError(t, st.UnmarshalText([]byte("items")))
+	assert.Equal(t, SizerTypeItems, st)
+	require.NoError(t, st.UnmarshalText([]byte("requests")))
+	assert.Equal(t, SizerTypeRequests, st)
+	require.EqualError(t, st.UnmarshalText([]byte("spans")), `unsupported sizer type "spans"`)
+}
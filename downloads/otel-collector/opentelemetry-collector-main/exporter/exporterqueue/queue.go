@@ -49,7 +49,7 @@ type Factory[T any] func(context.Context, Settings, Config) Queue[T]
 func NewMemoryQueueFactory[T itemsCounter]() Factory[T] {
 	return func(_ context.Context, _ Settings, cfg Config) Queue[T] {
 		return queue.NewBoundedMemoryQueue[T](queue.MemoryQueueSettings[T]{
-			Sizer:    sizerFromConfig[T](cfg),
+			Sizer:    sizerFromConfig[T](cfg, nil),
 			Capacity: capacityFromConfig(cfg),
 		})
 	}
@@ -63,6 +63,9 @@ type PersistentQueueSettings[T any] struct {
 	Marshaler Marshaler[T]
 	// Unmarshaler is used to deserialize requests after reading them from the persistent storage.
 	Unmarshaler Unmarshaler[T]
+	// StorageName is the name of the storage client the queue is persisted with. Defaults to the data type.
+	// It must be set to distinct values for the queues of the same exporter and data type sharing a storage extension.
+	StorageName string
 }
 
 // NewPersistentQueueFactory returns a factory to create a new persistent queue.
@@ -75,10 +78,11 @@ func NewPersistentQueueFactory[T itemsCounter](storageID *component.ID, factoryS
 	}
 	return func(_ context.Context, set Settings, cfg Config) Queue[T] {
 		return queue.NewPersistentQueue[T](queue.PersistentQueueSettings[T]{
-			Sizer:            sizerFromConfig[T](cfg),
+			Sizer:            sizerFromConfig[T](cfg, factorySettings.Marshaler),
 			Capacity:         capacityFromConfig(cfg),
 			DataType:         set.DataType,
 			StorageID:        *storageID,
+			StorageName:      factorySettings.StorageName,
 			Marshaler:        factorySettings.Marshaler,
 			Unmarshaler:      factorySettings.Unmarshaler,
 			ExporterSettings: set.ExporterSettings,
@@ -90,12 +94,20 @@ type itemsCounter interface {
 	ItemsCount() int
 }
 
-func sizerFromConfig[T itemsCounter](Config) queue.Sizer[T] {
-	// TODO: Handle other ways to measure the queue size once they are added.
-	return &queue.RequestSizer[T]{}
+// sizerFromConfig returns the queue.Sizer matching the configured SizerType.
+// The bytes sizer relies on the elements implementing `BytesSize() int`. The marshaler, if provided,
+// is used as a fallback to measure the elements that don't.
+func sizerFromConfig[T itemsCounter](cfg Config, marshaler Marshaler[T]) queue.Sizer[T] {
+	switch cfg.Sizer {
+	case SizerTypeItems:
+		return &queue.ItemsSizer[T]{}
+	case SizerTypeBytes:
+		return &queue.BytesSizer[T]{Marshaler: marshaler}
+	default:
+		return &queue.RequestSizer[T]{}
+	}
 }
 
 func capacityFromConfig(cfg Config) int64 {
-	// TODO: Handle other ways to measure the queue size once they are added.
 	return int64(cfg.QueueSize)
 }
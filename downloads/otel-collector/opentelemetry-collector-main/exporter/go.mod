@@ -6,7 +6,10 @@ require (
 	github.com/cenkalti/backoff/v4 v4.3.0
 	github.com/google/uuid v1.6.0
 	github.com/stretchr/testify v1.9.0
+	go.opentelemetry.io/collector/client v1.15.0
 	go.opentelemetry.io/collector/component v0.109.0
+	go.opentelemetry.io/collector/component/componentprofiles v0.109.0
+	go.opentelemetry.io/collector/component/componentstatus v0.109.0
 	go.opentelemetry.io/collector/config/configretry v1.15.0
 	go.opentelemetry.io/collector/config/configtelemetry v0.109.0
 	go.opentelemetry.io/collector/consumer v0.109.0
@@ -15,6 +18,7 @@ require (
 	go.opentelemetry.io/collector/exporter/exporterprofiles v0.109.0
 	go.opentelemetry.io/collector/extension v0.109.0
 	go.opentelemetry.io/collector/extension/experimental/storage v0.109.0
+	go.opentelemetry.io/collector/internal/otlptext v0.109.0
 	go.opentelemetry.io/collector/pdata v1.15.0
 	go.opentelemetry.io/collector/pdata/pprofile v0.109.0
 	go.opentelemetry.io/collector/pdata/testdata v0.109.0
@@ -27,7 +31,6 @@ require (
 	go.uber.org/goleak v1.3.0
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.27.0
-	golang.org/x/sys v0.25.0
 	google.golang.org/grpc v1.66.2
 )
 
@@ -42,6 +45,7 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	go.opentelemetry.io/collector/receiver/receiverprofiles v0.109.0 // indirect
 	golang.org/x/net v0.28.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/text v0.17.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240822170219-fc7c04adadcd // indirect
 	google.golang.org/protobuf v1.34.2 // indirect
@@ -50,6 +54,10 @@ require (
 
 replace go.opentelemetry.io/collector/component => ../component
 
+replace go.opentelemetry.io/collector/component/componentstatus => ../component/componentstatus
+
+replace go.opentelemetry.io/collector/component/componentprofiles => ../component/componentprofiles
+
 replace go.opentelemetry.io/collector/consumer => ../consumer
 
 replace go.opentelemetry.io/collector/extension => ../extension
@@ -77,3 +85,7 @@ replace go.opentelemetry.io/collector/consumer/consumertest => ../consumer/consu
 replace go.opentelemetry.io/collector/receiver/receiverprofiles => ../receiver/receiverprofiles
 
 replace go.opentelemetry.io/collector/exporter/exporterprofiles => ./exporterprofiles
+
+replace go.opentelemetry.io/collector/client => ../client
+
+replace go.opentelemetry.io/collector/internal/otlptext => ../internal/otlptext
@@ -10,18 +10,36 @@ import (
 	"go.opentelemetry.io/collector/component"
 )
 
+// ConsumersLimiter limits the number of consumers taking elements from the queue at the same time.
+type ConsumersLimiter interface {
+	// Acquire blocks until the consumer is allowed to take the next element from the queue.
+	Acquire()
+	// Release is called once the consumer is done with the element it took.
+	Release()
+}
+
 type Consumers[T any] struct {
 	queue        Queue[T]
 	numConsumers int
 	consumeFunc  func(context.Context, T) error
+	limiter      ConsumersLimiter
 	stopWG       sync.WaitGroup
 }
 
 func NewQueueConsumers[T any](q Queue[T], numConsumers int, consumeFunc func(context.Context, T) error) *Consumers[T] {
+	return NewQueueConsumersWithLimiter[T](q, numConsumers, consumeFunc, nil)
+}
+
+// NewQueueConsumersWithLimiter creates numConsumers consumers, of which only the ones allowed by the limiter
+// take elements from the queue at the same time. The limiter must let all the consumers through once the queue
+// is shut down, so the queue can be drained.
+func NewQueueConsumersWithLimiter[T any](q Queue[T], numConsumers int, consumeFunc func(context.Context, T) error,
+	limiter ConsumersLimiter) *Consumers[T] {
 	return &Consumers[T]{
 		queue:        q,
 		numConsumers: numConsumers,
 		consumeFunc:  consumeFunc,
+		limiter:      limiter,
 		stopWG:       sync.WaitGroup{},
 	}
 }
@@ -40,7 +58,7 @@ func (qc *Consumers[T]) Start(ctx context.Context, host component.Host) error {
 			startWG.Done()
 			defer qc.stopWG.Done()
 			for {
-				if !qc.queue.Consume(qc.consumeFunc) {
+				if !qc.consume() {
 					return
 				}
 			}
@@ -51,6 +69,15 @@ func (qc *Consumers[T]) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
+func (qc *Consumers[T]) consume() bool {
+	if qc.limiter == nil {
+		return qc.queue.Consume(qc.consumeFunc)
+	}
+	qc.limiter.Acquire()
+	defer qc.limiter.Release()
+	return qc.queue.Consume(qc.consumeFunc)
+}
+
 // Shutdown ensures that queue and all consumers are stopped.
 func (qc *Consumers[T]) Shutdown(ctx context.Context) error {
 	if err := qc.queue.Shutdown(ctx); err != nil {
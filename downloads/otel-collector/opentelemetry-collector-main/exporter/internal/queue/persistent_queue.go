@@ -73,6 +73,7 @@ const (
 	writeIndexKey               = "wi"
 	currentlyDispatchedItemsKey = "di"
 	queueSizeKey                = "si"
+	queueSizerKey               = "sk"
 )
 
 var (
@@ -83,10 +84,12 @@ var (
 )
 
 type PersistentQueueSettings[T any] struct {
-	Sizer            Sizer[T]
-	Capacity         int64
-	DataType         component.DataType
-	StorageID        component.ID
+	Sizer     Sizer[T]
+	Capacity  int64
+	DataType  component.DataType
+	StorageID component.ID
+	// StorageName is the name of the storage client. The data type is used if empty.
+	StorageName      string
 	Marshaler        func(req T) ([]byte, error)
 	Unmarshaler      func([]byte) (T, error)
 	ExporterSettings exporter.Settings
@@ -104,7 +107,11 @@ func NewPersistentQueue[T any](set PersistentQueueSettings[T]) Queue[T] {
 
 // Start starts the persistentQueue with the given number of consumers.
 func (pq *persistentQueue[T]) Start(ctx context.Context, host component.Host) error {
-	storageClient, err := toStorageClient(ctx, pq.set.StorageID, host, pq.set.ExporterSettings.ID, pq.set.DataType)
+	storageName := pq.set.StorageName
+	if storageName == "" {
+		storageName = pq.set.DataType.String()
+	}
+	storageClient, err := toStorageClient(ctx, pq.set.StorageID, host, pq.set.ExporterSettings.ID, storageName)
 	if err != nil {
 		return err
 	}
@@ -156,9 +163,7 @@ func (pq *persistentQueue[T]) initPersistentContiguousStorage(ctx context.Contex
 		initQueueSize = initIndexSize
 		// If the queue is sized by the number of requests, no need to read the queue size from storage.
 		if !pq.isRequestSized {
-			if restoredQueueSize, err := pq.restoreQueueSizeFromStorage(ctx); err == nil {
-				initQueueSize = restoredQueueSize
-			}
+			initQueueSize = pq.restoreQueueSize(ctx, initIndexSize)
 		}
 
 		// Ensure the communication channel filled with evenly sized elements up to the total restored queue size.
@@ -171,21 +176,70 @@ func (pq *persistentQueue[T]) initPersistentContiguousStorage(ctx context.Contex
 // permanentQueueEl is the type of the elements passed to the sizedChannel by the persistentQueue.
 type permanentQueueEl struct{}
 
+// restoreQueueSize restores the queue size from the snapshot in storage. If the snapshot cannot be read or it was
+// taken with a different sizer, the size is recalculated from the items stored in the queue.
+// The number of requests is returned if both fail.
+func (pq *persistentQueue[T]) restoreQueueSize(ctx context.Context, requestsCount uint64) uint64 {
+	restoredQueueSize, err := pq.restoreQueueSizeFromStorage(ctx)
+	if err == nil {
+		return restoredQueueSize
+	}
+
+	pq.logger.Info("Recalculating the queue size from the items in storage", zap.Error(err))
+	calculatedQueueSize, err := pq.calculateQueueSizeFromStorage(ctx)
+	if err != nil {
+		pq.logger.Error("Failed to recalculate the queue size from storage. "+
+			"The reported queue size will be inaccurate until the initial queue is drained.", zap.Error(err))
+		return requestsCount
+	}
+	return calculatedQueueSize
+}
+
 // restoreQueueSizeFromStorage restores the queue size from storage.
 func (pq *persistentQueue[T]) restoreQueueSizeFromStorage(ctx context.Context) (uint64, error) {
-	val, err := pq.client.Get(ctx, queueSizeKey)
-	if err != nil {
-		if errors.Is(err, errValueNotSet) {
-			pq.logger.Warn("Cannot read the queue size snapshot from storage. "+
-				"The reported queue size will be inaccurate until the initial queue is drained. "+
-				"It's expected when the items sized queue enabled for the first time", zap.Error(err))
-		} else {
-			pq.logger.Error("Failed to read the queue size snapshot from storage. "+
-				"The reported queue size will be inaccurate until the initial queue is drained.", zap.Error(err))
-		}
+	sizeOp := storage.GetOperation(queueSizeKey)
+	sizerOp := storage.GetOperation(queueSizerKey)
+	if err := pq.client.Batch(ctx, sizeOp, sizerOp); err != nil {
 		return 0, err
 	}
-	return bytesToItemIndex(val)
+	// Snapshots taken before the sizer was recorded along with the size were always measured in items.
+	sizer := "items"
+	if sizerOp.Value != nil {
+		sizer = string(sizerOp.Value)
+	}
+	if sizer != pq.sizerKind() {
+		return 0, fmt.Errorf("queue size snapshot is measured in %q, not in %q", sizer, pq.sizerKind())
+	}
+	return bytesToItemIndex(sizeOp.Value)
+}
+
+// calculateQueueSizeFromStorage reads all the items that are not yet dispatched and sums up their sizes.
+// The items that cannot be read are skipped because they are dropped by the consumers as well.
+func (pq *persistentQueue[T]) calculateQueueSizeFromStorage(ctx context.Context) (uint64, error) {
+	var size uint64
+	for index := pq.readIndex; index < pq.writeIndex; index++ {
+		buf, err := pq.client.Get(ctx, getItemKey(index))
+		if err != nil {
+			return 0, err
+		}
+		if buf == nil {
+			continue
+		}
+		req, err := pq.set.Unmarshaler(buf)
+		if err != nil {
+			continue
+		}
+		size += uint64(pq.set.Sizer.Sizeof(req))
+	}
+	return size, nil
+}
+
+// sizerKind returns the identifier of the sizer stored along with the queue size snapshot.
+func (pq *persistentQueue[T]) sizerKind() string {
+	if sk, ok := pq.set.Sizer.(sizerKind); ok {
+		return sk.kind()
+	}
+	return ""
 }
 
 // Consume applies the provided function on the head of queue.
@@ -233,16 +287,19 @@ func (pq *persistentQueue[T]) Shutdown(ctx context.Context) error {
 	return multierr.Combine(backupErr, pq.unrefClient(ctx))
 }
 
-// backupQueueSize writes the current queue size to storage. The value is used to recover the queue size
-// in case if the collector is killed.
+// backupQueueSize writes the current queue size to storage along with the sizer it's measured by.
+// The value is used to recover the queue size in case if the collector is killed.
 func (pq *persistentQueue[T]) backupQueueSize(ctx context.Context) error {
-	// No need to write the queue size if the queue is sized by the number of requests.
-	// That information is already stored as difference between read and write indexes.
+	// The size of the queue sized by the number of requests is already stored as difference between
+	// read and write indexes. Only record the sizer, so a stale snapshot taken by another sizer is not
+	// restored if the sizer is switched back later.
 	if pq.isRequestSized {
-		return nil
+		return pq.client.Set(ctx, queueSizerKey, []byte(pq.sizerKind()))
 	}
 
-	return pq.client.Set(ctx, queueSizeKey, itemIndexToBytes(uint64(pq.Size())))
+	return pq.client.Batch(ctx,
+		storage.SetOperation(queueSizeKey, itemIndexToBytes(uint64(pq.Size()))),
+		storage.SetOperation(queueSizerKey, []byte(pq.sizerKind())))
 }
 
 // unrefClient unrefs the client, and closes if no more references. Callers MUST hold the mutex.
@@ -485,7 +542,7 @@ func (pq *persistentQueue[T]) itemDispatchingFinish(ctx context.Context, index u
 	return nil
 }
 
-func toStorageClient(ctx context.Context, storageID component.ID, host component.Host, ownerID component.ID, signal component.DataType) (storage.Client, error) {
+func toStorageClient(ctx context.Context, storageID component.ID, host component.Host, ownerID component.ID, name string) (storage.Client, error) {
 	ext, found := host.GetExtensions()[storageID]
 	if !found {
 		return nil, errNoStorageClient
@@ -496,7 +553,7 @@ func toStorageClient(ctx context.Context, storageID component.ID, host component
 		return nil, errWrongExtensionType
 	}
 
-	return storageExt.GetClient(ctx, component.KindExporter, ownerID, signal.String())
+	return storageExt.GetClient(ctx, component.KindExporter, ownerID, name)
 }
 
 func getItemKey(index uint64) string {
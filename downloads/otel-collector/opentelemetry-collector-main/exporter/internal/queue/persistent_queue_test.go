@@ -77,6 +77,25 @@ func createAndStartTestPersistentQueue(t *testing.T, sizer Sizer[tracesRequest],
 	return pq
 }
 
+func TestIsPersistent(t *testing.T) {
+	mq := NewBoundedMemoryQueue[tracesRequest](MemoryQueueSettings[tracesRequest]{Sizer: &RequestSizer[tracesRequest]{}, Capacity: 1})
+	assert.False(t, IsPersistent(mq))
+
+	pq := NewPersistentQueue[tracesRequest](PersistentQueueSettings[tracesRequest]{
+		Sizer:            &RequestSizer[tracesRequest]{},
+		Capacity:         1,
+		DataType:         component.DataTypeTraces,
+		Marshaler:        marshalTracesRequest,
+		Unmarshaler:      unmarshalTracesRequest,
+		ExporterSettings: exportertest.NewNopSettings(),
+	})
+	assert.True(t, IsPersistent(pq))
+
+	// A priority queue is persistent if any of its lanes is.
+	assert.False(t, IsPersistent(NewPriorityQueue[tracesRequest](PriorityQueueSettings[tracesRequest]{Lanes: []Queue[tracesRequest]{mq}})))
+	assert.True(t, IsPersistent(NewPriorityQueue[tracesRequest](PriorityQueueSettings[tracesRequest]{Lanes: []Queue[tracesRequest]{mq, pq}})))
+}
+
 func createTestPersistentQueueWithClient(client storage.Client) *persistentQueue[tracesRequest] {
 	pq := NewPersistentQueue[tracesRequest](PersistentQueueSettings[tracesRequest]{
 		Sizer:            &RequestSizer[tracesRequest]{},
@@ -305,7 +324,7 @@ func TestToStorageClient(t *testing.T) {
 			ownerID := component.MustNewID("foo_exporter")
 
 			// execute
-			client, err := toStorageClient(context.Background(), storageID, host, ownerID, component.DataTypeTraces)
+			client, err := toStorageClient(context.Background(), storageID, host, ownerID, component.DataTypeTraces.String())
 
 			// verify
 			if tt.expectedError != nil {
@@ -335,7 +354,7 @@ func TestInvalidStorageExtensionType(t *testing.T) {
 	ownerID := component.MustNewID("foo_exporter")
 
 	// execute
-	client, err := toStorageClient(context.Background(), storageID, host, ownerID, component.DataTypeTraces)
+	client, err := toStorageClient(context.Background(), storageID, host, ownerID, component.DataTypeTraces.String())
 
 	// we should get an error about the extension type
 	require.ErrorIs(t, err, errWrongExtensionType)
@@ -889,7 +908,7 @@ func TestPersistentQueue_ItemsCapacityUsageRestoredOnShutdown(t *testing.T) {
 }
 
 // This test covers the case when the items capacity queue is enabled for the first time.
-func TestPersistentQueue_ItemsCapacityUsageIsNotPreserved(t *testing.T) {
+func TestPersistentQueue_ItemsCapacityUsageIsRecalculated(t *testing.T) {
 	ext := NewMockStorageExtension(nil)
 	pq := createTestPersistentQueueWithRequestsCapacity(t, ext, 100)
 
@@ -910,38 +929,67 @@ func TestPersistentQueue_ItemsCapacityUsageIsNotPreserved(t *testing.T) {
 
 	newPQ := createTestPersistentQueueWithItemsCapacity(t, ext, 100)
 
-	// The queue items size cannot be restored, fall back to request-based size
-	assert.Equal(t, 2, newPQ.Size())
+	// The queue items size cannot be restored from the snapshot, it's recalculated from the stored items.
+	assert.Equal(t, 45, newPQ.Size())
 
 	require.NoError(t, newPQ.Offer(context.Background(), newTracesRequest(2, 5)))
+	assert.Equal(t, 55, newPQ.Size())
 
-	// Only new items are correctly reflected
-	assert.Equal(t, 12, newPQ.Size())
-
-	// Consuming a restored request should reduce the restored size by 20 but it should not go to below zero
 	assert.True(t, newPQ.Consume(func(_ context.Context, traces tracesRequest) error {
 		assert.Equal(t, 20, traces.traces.SpanCount())
 		return nil
 	}))
-	assert.Equal(t, 0, newPQ.Size())
+	assert.Equal(t, 35, newPQ.Size())
 
-	// Consuming another restored request should not affect the restored size since it's already dropped to 0.
 	assert.True(t, newPQ.Consume(func(_ context.Context, traces tracesRequest) error {
 		assert.Equal(t, 25, traces.traces.SpanCount())
 		return nil
 	}))
-	assert.Equal(t, 0, newPQ.Size())
+	assert.Equal(t, 10, newPQ.Size())
 
-	// Adding another batch should update the size accordingly
-	require.NoError(t, newPQ.Offer(context.Background(), newTracesRequest(5, 5)))
-	assert.Equal(t, 25, newPQ.Size())
+	assert.NoError(t, newPQ.Shutdown(context.Background()))
+}
 
-	assert.True(t, newPQ.Consume(func(_ context.Context, traces tracesRequest) error {
-		assert.Equal(t, 10, traces.traces.SpanCount())
-		return nil
-	}))
-	assert.Equal(t, 15, newPQ.Size())
+// This test covers the case when the queue is switched back to a sizer which left a stale snapshot in the storage.
+func TestPersistentQueue_StaleSnapshotIsNotRestored(t *testing.T) {
+	ext := NewMockStorageExtension(nil)
+	pq := createTestPersistentQueueWithItemsCapacity(t, ext, 100)
+	require.NoError(t, pq.Offer(context.Background(), newTracesRequest(2, 10)))
+	require.NoError(t, pq.Offer(context.Background(), newTracesRequest(4, 10)))
+	// Read the first request to populate the read index in the storage.
+	assert.True(t, pq.Consume(func(context.Context, tracesRequest) error { return nil }))
+	require.NoError(t, pq.Shutdown(context.Background()))
 
+	pq = createTestPersistentQueueWithRequestsCapacity(t, ext, 100)
+	assert.Equal(t, 1, pq.Size())
+	assert.True(t, pq.Consume(func(context.Context, tracesRequest) error { return nil }))
+	require.NoError(t, pq.Offer(context.Background(), newTracesRequest(1, 5)))
+	require.NoError(t, pq.Shutdown(context.Background()))
+
+	pq = createTestPersistentQueueWithItemsCapacity(t, ext, 100)
+	assert.Equal(t, 5, pq.Size())
+	assert.NoError(t, pq.Shutdown(context.Background()))
+}
+
+func TestPersistentQueue_BytesCapacityUsageRestoredOnShutdown(t *testing.T) {
+	ext := NewMockStorageExtension(nil)
+	sizer := &BytesSizer[tracesRequest]{Marshaler: marshalTracesRequest}
+	req := newTracesRequest(2, 5)
+	reqSize := int(sizer.Sizeof(req))
+
+	pq := createTestPersistentQueueWithCapacityLimiter(t, ext, sizer, int64(3*reqSize))
+	require.NoError(t, pq.Offer(context.Background(), req))
+	require.NoError(t, pq.Offer(context.Background(), req))
+	require.NoError(t, pq.Offer(context.Background(), req))
+	require.ErrorIs(t, pq.Offer(context.Background(), req), ErrQueueIsFull)
+	assert.Equal(t, 3*reqSize, pq.Size())
+	assert.True(t, pq.Consume(func(context.Context, tracesRequest) error { return nil }))
+	require.NoError(t, pq.Shutdown(context.Background()))
+
+	newPQ := createTestPersistentQueueWithCapacityLimiter(t, ext, sizer, int64(3*reqSize))
+	assert.Equal(t, 2*reqSize, newPQ.Size())
+	assert.True(t, newPQ.Consume(func(context.Context, tracesRequest) error { return nil }))
+	assert.Equal(t, reqSize, newPQ.Size())
 	assert.NoError(t, newPQ.Shutdown(context.Background()))
 }
 
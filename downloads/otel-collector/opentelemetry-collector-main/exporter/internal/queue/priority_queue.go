@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package queue // import "go.opentelemetry.io/collector/exporter/internal/queue"
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/multierr"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// lengther is implemented by the queues of this package to report the number of elements they hold,
+// regardless of the sizer they use.
+type lengther interface {
+	length() int
+}
+
+// PriorityQueueSettings defines internal parameters for priorityQueue creation.
+type PriorityQueueSettings[T any] struct {
+	// Lanes are the queues the elements are routed to, ordered from the highest to the lowest priority.
+	Lanes []Queue[T]
+	// LaneFunc returns the index of the lane the element is put into.
+	LaneFunc func(context.Context, T) int
+}
+
+// priorityQueue routes the elements into several lanes, each with its own capacity.
+// Consumers always take the next element from the highest priority lane that is not empty.
+type priorityQueue[T any] struct {
+	lanes    []Queue[T]
+	laneFunc func(context.Context, T) int
+
+	// mu guards everything declared below.
+	mu   sync.Mutex
+	cond *sync.Cond
+	// pending is the number of elements of every lane that are not claimed by a consumer yet.
+	pending []int
+	stopped bool
+}
+
+// NewPriorityQueue constructs a queue that drains the higher priority lanes first.
+func NewPriorityQueue[T any](set PriorityQueueSettings[T]) Queue[T] {
+	pq := &priorityQueue[T]{
+		lanes:    set.Lanes,
+		laneFunc: set.LaneFunc,
+		pending:  make([]int, len(set.Lanes)),
+	}
+	pq.cond = sync.NewCond(&pq.mu)
+	return pq
+}
+
+// Start starts all the lanes and accounts for the elements they were restored with.
+func (pq *priorityQueue[T]) Start(ctx context.Context, host component.Host) error {
+	for i, lane := range pq.lanes {
+		if err := lane.Start(ctx, host); err != nil {
+			return err
+		}
+		if l, ok := lane.(lengther); ok {
+			pq.mu.Lock()
+			pq.pending[i] += l.length()
+			pq.mu.Unlock()
+		}
+	}
+	pq.cond.Broadcast()
+	return nil
+}
+
+// Offer puts the element into the lane selected for it.
+// It returns ErrQueueIsFull if the selected lane is full, even if other lanes have capacity left.
+func (pq *priorityQueue[T]) Offer(ctx context.Context, req T) error {
+	i := pq.laneFunc(ctx, req)
+	if err := pq.lanes[i].Offer(ctx, req); err != nil {
+		return err
+	}
+	pq.mu.Lock()
+	pq.pending[i]++
+	pq.mu.Unlock()
+	pq.cond.Signal()
+	return nil
+}
+
+// Consume applies the provided function on the head of the highest priority lane that is not empty.
+// The call blocks until there is an item available or the queue is stopped.
+// The function returns true when an item is consumed or false if the queue is stopped and emptied.
+func (pq *priorityQueue[T]) Consume(consumeFunc func(context.Context, T) error) bool {
+	for {
+		i, ok := pq.claim()
+		if !ok {
+			return false
+		}
+		// The lane may have nothing left to consume if it's stopped, try the next claimed element.
+		if pq.lanes[i].Consume(consumeFunc) {
+			return true
+		}
+	}
+}
+
+// claim blocks until an element is available and returns the index of the highest priority lane holding one.
+// It returns false if the queue is stopped and all the elements are claimed.
+func (pq *priorityQueue[T]) claim() (int, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	for {
+		for i := range pq.pending {
+			if pq.pending[i] > 0 {
+				pq.pending[i]--
+				return i, true
+			}
+		}
+		if pq.stopped {
+			return 0, false
+		}
+		pq.cond.Wait()
+	}
+}
+
+// Shutdown stops all the lanes. The elements left in the lanes are still drained by the consumers.
+func (pq *priorityQueue[T]) Shutdown(ctx context.Context) error {
+	var errs error
+	for _, lane := range pq.lanes {
+		errs = multierr.Append(errs, lane.Shutdown(ctx))
+	}
+	pq.mu.Lock()
+	pq.stopped = true
+	pq.mu.Unlock()
+	pq.cond.Broadcast()
+	return errs
+}
+
+// Size returns the total size of all the lanes.
+func (pq *priorityQueue[T]) Size() int {
+	size := 0
+	for _, lane := range pq.lanes {
+		size += lane.Size()
+	}
+	return size
+}
+
+// Capacity returns the total capacity of all the lanes.
+func (pq *priorityQueue[T]) Capacity() int {
+	capacity := 0
+	for _, lane := range pq.lanes {
+		capacity += lane.Capacity()
+	}
+	return capacity
+}
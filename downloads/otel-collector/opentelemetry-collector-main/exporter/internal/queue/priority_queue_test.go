@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+)
+
+// newTestPriorityQueue creates a queue with a "high" and a "low" lane of the given capacities.
+// The strings starting with "high" are routed to the high priority lane.
+func newTestPriorityQueue(highCapacity, lowCapacity int64) Queue[string] {
+	return NewPriorityQueue[string](PriorityQueueSettings[string]{
+		Lanes: []Queue[string]{
+			NewBoundedMemoryQueue[string](MemoryQueueSettings[string]{Sizer: &RequestSizer[string]{}, Capacity: highCapacity}),
+			NewBoundedMemoryQueue[string](MemoryQueueSettings[string]{Sizer: &RequestSizer[string]{}, Capacity: lowCapacity}),
+		},
+		LaneFunc: func(_ context.Context, item string) int {
+			if strings.HasPrefix(item, "high") {
+				return 0
+			}
+			return 1
+		},
+	})
+}
+
+func consumeAll(t *testing.T, q Queue[string], count int) []string {
+	var consumed []string
+	for i := 0; i < count; i++ {
+		assert.True(t, q.Consume(func(_ context.Context, item string) error {
+			consumed = append(consumed, item)
+			return nil
+		}))
+	}
+	return consumed
+}
+
+func TestPriorityQueue_DrainsHigherPriorityFirst(t *testing.T) {
+	q := newTestPriorityQueue(10, 10)
+	require.NoError(t, q.Start(context.Background(), componenttest.NewNopHost()))
+
+	for _, item := range []string{"low-1", "high-1", "low-2", "high-2"} {
+		require.NoError(t, q.Offer(context.Background(), item))
+	}
+	assert.Equal(t, 4, q.Size())
+	assert.Equal(t, 20, q.Capacity())
+
+	assert.Equal(t, []string{"high-1", "high-2", "low-1", "low-2"}, consumeAll(t, q, 4))
+	assert.Equal(t, 0, q.Size())
+	require.NoError(t, q.Shutdown(context.Background()))
+}
+
+func TestPriorityQueue_LaneCapacity(t *testing.T) {
+	q := newTestPriorityQueue(1, 2)
+	require.NoError(t, q.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, q.Offer(context.Background(), "low-1"))
+	require.NoError(t, q.Offer(context.Background(), "low-2"))
+	require.ErrorIs(t, q.Offer(context.Background(), "low-3"), ErrQueueIsFull)
+
+	// The full low priority lane does not prevent the high priority lane from accepting new items.
+	require.NoError(t, q.Offer(context.Background(), "high-1"))
+	require.ErrorIs(t, q.Offer(context.Background(), "high-2"), ErrQueueIsFull)
+
+	assert.Equal(t, []string{"high-1", "low-1", "low-2"}, consumeAll(t, q, 3))
+	require.NoError(t, q.Shutdown(context.Background()))
+}
+
+func TestPriorityQueue_ConsumeBlocksUntilOffer(t *testing.T) {
+	q := newTestPriorityQueue(10, 10)
+	require.NoError(t, q.Start(context.Background(), componenttest.NewNopHost()))
+
+	var wg sync.WaitGroup
+	var consumed string
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.True(t, q.Consume(func(_ context.Context, item string) error {
+			consumed = item
+			return nil
+		}))
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, q.Offer(context.Background(), "low-1"))
+	wg.Wait()
+	assert.Equal(t, "low-1", consumed)
+	require.NoError(t, q.Shutdown(context.Background()))
+}
+
+func TestPriorityQueue_ShutdownWhileNotEmpty(t *testing.T) {
+	q := newTestPriorityQueue(10, 10)
+	require.NoError(t, q.Start(context.Background(), componenttest.NewNopHost()))
+
+	for _, item := range []string{"low-1", "high-1"} {
+		require.NoError(t, q.Offer(context.Background(), item))
+	}
+	require.NoError(t, q.Shutdown(context.Background()))
+
+	// The remaining items are still drained after shutdown.
+	assert.Equal(t, []string{"high-1", "low-1"}, consumeAll(t, q, 2))
+	assert.False(t, q.Consume(func(_ context.Context, item string) error {
+		panic(item)
+	}))
+}
+
+func TestPriorityQueue_RestoredLane(t *testing.T) {
+	newPersistentLane := func() Queue[tracesRequest] {
+		return NewPersistentQueue[tracesRequest](PersistentQueueSettings[tracesRequest]{
+			Sizer:            &RequestSizer[tracesRequest]{},
+			Capacity:         10,
+			DataType:         component.DataTypeTraces,
+			StorageID:        component.ID{},
+			StorageName:      "traces_low",
+			Marshaler:        marshalTracesRequest,
+			Unmarshaler:      unmarshalTracesRequest,
+			ExporterSettings: exportertest.NewNopSettings(),
+		})
+	}
+	newQueue := func(lane Queue[tracesRequest]) Queue[tracesRequest] {
+		return NewPriorityQueue[tracesRequest](PriorityQueueSettings[tracesRequest]{
+			Lanes: []Queue[tracesRequest]{
+				NewBoundedMemoryQueue[tracesRequest](MemoryQueueSettings[tracesRequest]{Sizer: &RequestSizer[tracesRequest]{}, Capacity: 10}),
+				lane,
+			},
+			LaneFunc: func(context.Context, tracesRequest) int { return 1 },
+		})
+	}
+	host := &mockHost{ext: map[component.ID]component.Component{
+		{}: NewMockStorageExtension(nil),
+	}}
+
+	q := newQueue(newPersistentLane())
+	require.NoError(t, q.Start(context.Background(), host))
+	require.NoError(t, q.Offer(context.Background(), newTracesRequest(1, 1)))
+	require.NoError(t, q.Offer(context.Background(), newTracesRequest(1, 2)))
+	require.NoError(t, q.Offer(context.Background(), newTracesRequest(1, 3)))
+	assert.True(t, q.Consume(func(context.Context, tracesRequest) error { return nil }))
+	require.NoError(t, q.Shutdown(context.Background()))
+
+	// The items restored from the storage can be consumed after restart.
+	q = newQueue(newPersistentLane())
+	require.NoError(t, q.Start(context.Background(), host))
+	assert.Equal(t, 2, q.Size())
+	var spans []int
+	for i := 0; i < 2; i++ {
+		assert.True(t, q.Consume(func(_ context.Context, req tracesRequest) error {
+			spans = append(spans, req.traces.SpanCount())
+			return nil
+		}))
+	}
+	assert.Equal(t, []int{2, 3}, spans)
+	require.NoError(t, q.Shutdown(context.Background()))
+}
@@ -35,15 +35,41 @@ type Queue[T any] interface {
 	Capacity() int
 }
 
+// IsPersistent returns true if the elements of the queue are written to a storage extension.
+// Only the elements are persisted, the context they are offered with is not available to the consumers.
+func IsPersistent[T any](q Queue[T]) bool {
+	switch tq := q.(type) {
+	case *persistentQueue[T]:
+		return true
+	case *priorityQueue[T]:
+		for _, lane := range tq.lanes {
+			if IsPersistent(lane) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type itemsCounter interface {
 	ItemsCount() int
 }
 
+type bytesCounter interface {
+	BytesSize() int
+}
+
 // Sizer is an interface that returns the size of the given element.
 type Sizer[T any] interface {
 	Sizeof(T) int64
 }
 
+// sizerKind is implemented by the Sizers provided by this package to identify the unit of the queue size
+// persisted by the persistent queue.
+type sizerKind interface {
+	kind() string
+}
+
 // ItemsSizer is a Sizer implementation that returns the size of a queue element as the number of items it contains.
 type ItemsSizer[T itemsCounter] struct{}
 
@@ -51,9 +77,40 @@ func (is *ItemsSizer[T]) Sizeof(el T) int64 {
 	return int64(el.ItemsCount())
 }
 
+func (is *ItemsSizer[T]) kind() string {
+	return "items"
+}
+
 // RequestSizer is a Sizer implementation that returns the size of a queue element as one request.
 type RequestSizer[T any] struct{}
 
 func (rs *RequestSizer[T]) Sizeof(T) int64 {
 	return 1
 }
+
+func (rs *RequestSizer[T]) kind() string {
+	return "requests"
+}
+
+// BytesSizer is a Sizer implementation that returns the size of a queue element as the number of bytes it occupies
+// once marshaled. The elements are expected to implement `BytesSize() int`, otherwise the optional Marshaler is used
+// to measure them. Elements that cannot be measured at all are accounted as a single byte.
+type BytesSizer[T any] struct {
+	Marshaler func(T) ([]byte, error)
+}
+
+func (bs *BytesSizer[T]) Sizeof(el T) int64 {
+	if bc, ok := any(el).(bytesCounter); ok {
+		return int64(bc.BytesSize())
+	}
+	if bs.Marshaler != nil {
+		if buf, err := bs.Marshaler(el); err == nil {
+			return int64(len(buf))
+		}
+	}
+	return 1
+}
+
+func (bs *BytesSizer[T]) kind() string {
+	return "bytes"
+}
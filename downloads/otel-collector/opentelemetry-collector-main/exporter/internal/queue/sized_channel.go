@@ -110,3 +110,8 @@ func (vcq *sizedChannel[T]) Size() int {
 func (vcq *sizedChannel[T]) Capacity() int {
 	return int(vcq.cap)
 }
+
+// length returns the number of elements in the channel.
+func (vcq *sizedChannel[T]) length() int {
+	return len(vcq.ch)
+}
@@ -24,6 +24,9 @@ type Config struct {
 	exporterhelper.QueueConfig   `mapstructure:"sending_queue"`
 	RetryConfig                  configretry.BackOffConfig `mapstructure:"retry_on_failure"`
 
+	// DeadLetterConfig stores the requests that failed permanently, or ran out of retries, in a storage extension.
+	DeadLetterConfig exporterhelper.DeadLetterConfig `mapstructure:"dead_letter"`
+
 	// Experimental: This configuration is at the early stage of development and may change without backward compatibility
 	// until https://github.com/open-telemetry/opentelemetry-collector/issues/8122 is resolved
 	BatcherConfig exporterbatcher.Config `mapstructure:"batcher"`
@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterbatcher"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exporterqueue"
 )
 
 func TestUnmarshalDefaultConfig(t *testing.T) {
@@ -52,6 +53,7 @@ func TestUnmarshalConfig(t *testing.T) {
 			QueueConfig: exporterhelper.QueueConfig{
 				Enabled:      true,
 				NumConsumers: 2,
+				Sizer:        exporterqueue.SizerTypeRequests,
 				QueueSize:    10,
 			},
 			BatcherConfig: exporterbatcher.Config{
@@ -63,6 +65,8 @@ func TestUnmarshalConfig(t *testing.T) {
 				MaxSizeConfig: exporterbatcher.MaxSizeConfig{
 					MaxSizeItems: 10000,
 				},
+				MetadataKeys:             []string{"X-Scope-OrgID"},
+				MetadataCardinalityLimit: 1000,
 			},
 			ClientConfig: configgrpc.ClientConfig{
 				Headers: map[string]configopaque.String{
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -47,6 +48,18 @@ func TestCreateMetricsExporter(t *testing.T) {
 	require.NotNil(t, oexp)
 }
 
+func TestCreateProfilesExporter(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = testutil.GetAvailableLocalAddress(t)
+
+	set := exportertest.NewNopSettings()
+	oexp, err := factory.CreateProfilesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, oexp)
+	assert.Equal(t, component.StabilityLevelDevelopment, factory.ProfilesExporterStability())
+}
+
 func TestCreateTracesExporter(t *testing.T) {
 	endpoint := testutil.GetAvailableLocalAddress(t)
 	tests := []struct {
@@ -24,6 +24,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
@@ -33,12 +35,13 @@ type baseExporter struct {
 	config *Config
 
 	// gRPC clients and connection.
-	traceExporter  ptraceotlp.GRPCClient
-	metricExporter pmetricotlp.GRPCClient
-	logExporter    plogotlp.GRPCClient
-	clientConn     *grpc.ClientConn
-	metadata       metadata.MD
-	callOptions    []grpc.CallOption
+	traceExporter   ptraceotlp.GRPCClient
+	metricExporter  pmetricotlp.GRPCClient
+	logExporter     plogotlp.GRPCClient
+	profileExporter pprofileotlp.GRPCClient
+	clientConn      *grpc.ClientConn
+	metadata        metadata.MD
+	callOptions     []grpc.CallOption
 
 	settings component.TelemetrySettings
 
@@ -64,6 +67,7 @@ func (e *baseExporter) start(ctx context.Context, host component.Host) (err erro
 	e.traceExporter = ptraceotlp.NewGRPCClient(e.clientConn)
 	e.metricExporter = pmetricotlp.NewGRPCClient(e.clientConn)
 	e.logExporter = plogotlp.NewGRPCClient(e.clientConn)
+	e.profileExporter = pprofileotlp.NewGRPCClient(e.clientConn)
 	headers := map[string]string{}
 	for k, v := range e.config.ClientConfig.Headers {
 		headers[k] = string(v)
@@ -95,6 +99,7 @@ func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 			zap.String("message", resp.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_spans", resp.PartialSuccess().RejectedSpans()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedSpans(), partialSuccess.ErrorMessage())
 	}
 	return nil
 }
@@ -111,6 +116,7 @@ func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) erro
 			zap.String("message", resp.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_data_points", resp.PartialSuccess().RejectedDataPoints()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedDataPoints(), partialSuccess.ErrorMessage())
 	}
 	return nil
 }
@@ -127,6 +133,25 @@ func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 			zap.String("message", resp.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_log_records", resp.PartialSuccess().RejectedLogRecords()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedLogRecords(), partialSuccess.ErrorMessage())
+	}
+	return nil
+}
+
+func (e *baseExporter) pushProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	req := pprofileotlp.NewExportRequestFromProfiles(pd)
+	resp, respErr := e.profileExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+	if err := processError(respErr); err != nil {
+		return err
+	}
+	// The rejected profiles are only logged: the exporter telemetry counts the profiles in samples,
+	// and the response doesn't tell how many samples were rejected.
+	partialSuccess := resp.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedProfiles() == 0) {
+		e.settings.Logger.Warn("Partial success response",
+			zap.String("message", resp.PartialSuccess().ErrorMessage()),
+			zap.Int64("dropped_profiles", resp.PartialSuccess().RejectedProfiles()),
+		)
 	}
 	return nil
 }
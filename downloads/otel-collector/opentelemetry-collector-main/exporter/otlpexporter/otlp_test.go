@@ -35,6 +35,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/pdata/testdata"
@@ -223,6 +225,55 @@ func otlpMetricsReceiverOnGRPCServer(ln net.Listener) *mockMetricsReceiver {
 	return rcv
 }
 
+type mockProfilesReceiver struct {
+	pprofileotlp.UnimplementedGRPCServer
+	mockReceiver
+	exportResponse func() pprofileotlp.ExportResponse
+	lastRequest    pprofile.Profiles
+}
+
+func (r *mockProfilesReceiver) Export(ctx context.Context, req pprofileotlp.ExportRequest) (pprofileotlp.ExportResponse, error) {
+	r.requestCount.Add(int32(1))
+	pd := req.Profiles()
+	r.totalItems.Add(int32(pd.SampleCount()))
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.lastRequest = pd
+	r.metadata, _ = metadata.FromIncomingContext(ctx)
+	return r.exportResponse(), r.exportError
+}
+
+func (r *mockProfilesReceiver) getLastRequest() pprofile.Profiles {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.lastRequest
+}
+
+func (r *mockProfilesReceiver) setExportResponse(fn func() pprofileotlp.ExportResponse) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.exportResponse = fn
+}
+
+func otlpProfilesReceiverOnGRPCServer(ln net.Listener) *mockProfilesReceiver {
+	rcv := &mockProfilesReceiver{
+		mockReceiver: mockReceiver{
+			srv:          grpc.NewServer(),
+			requestCount: &atomic.Int32{},
+			totalItems:   &atomic.Int32{},
+		},
+		exportResponse: pprofileotlp.NewExportResponse,
+	}
+
+	// Now run it as a gRPC server
+	pprofileotlp.RegisterGRPCServer(rcv.srv, rcv)
+	go func() {
+		_ = rcv.srv.Serve(ln)
+	}()
+
+	return rcv
+}
+
 func TestSendTraces(t *testing.T) {
 	// Start an OTLP-compatible receiver.
 	ln, err := net.Listen("tcp", "localhost:")
@@ -795,3 +846,89 @@ func TestSendLogData(t *testing.T) {
 	assert.Len(t, observed.FilterLevelExact(zap.WarnLevel).All(), 1)
 	assert.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
 }
+
+func TestSendProfiles(t *testing.T) {
+	// Start an OTLP-compatible receiver.
+	ln, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err, "Failed to find an available address to run the gRPC server: %v", err)
+	rcv := otlpProfilesReceiverOnGRPCServer(ln)
+	// Also closes the connection.
+	defer rcv.srv.GracefulStop()
+
+	// Start an OTLP exporter and point to the receiver.
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	// Disable queuing to ensure that we execute the request when calling ConsumeProfiles
+	// otherwise we will not see any errors.
+	cfg.QueueConfig.Enabled = false
+	cfg.ClientConfig = configgrpc.ClientConfig{
+		Endpoint: ln.Addr().String(),
+		TLSSetting: configtls.ClientConfig{
+			Insecure: true,
+		},
+		Headers: map[string]configopaque.String{
+			"header": "header-value",
+		},
+	}
+	set := exportertest.NewNopSettings()
+	set.BuildInfo.Description = "Collector"
+	set.BuildInfo.Version = "1.2.3test"
+
+	// For testing the "Partial success" warning.
+	logger, observed := observer.New(zap.DebugLevel)
+	set.TelemetrySettings.Logger = zap.New(logger)
+
+	exp, err := factory.CreateProfilesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, exp)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	host := componenttest.NewNopHost()
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	// Ensure that initially there is no data in the receiver.
+	assert.EqualValues(t, 0, rcv.requestCount.Load())
+
+	// A request with 2 profiles.
+	pd := testdata.GenerateProfiles(2)
+	require.NoError(t, exp.ConsumeProfiles(context.Background(), pd))
+
+	// Wait until it is received.
+	assert.Eventually(t, func() bool {
+		return rcv.requestCount.Load() > 0
+	}, 10*time.Second, 5*time.Millisecond)
+
+	// Verify received profiles.
+	assert.EqualValues(t, 1, rcv.requestCount.Load())
+	assert.EqualValues(t, 2, rcv.totalItems.Load())
+	assert.EqualValues(t, pd, rcv.getLastRequest())
+
+	md := rcv.getMetadata()
+	require.EqualValues(t, []string{"header-value"}, md.Get("header"))
+	require.Len(t, md.Get("User-Agent"), 1)
+	require.Contains(t, md.Get("User-Agent")[0], "Collector/1.2.3test")
+
+	st := status.New(codes.InvalidArgument, "Invalid argument")
+	rcv.setExportError(st.Err())
+
+	// Non-retryable errors are returned right away.
+	require.Error(t, exp.ConsumeProfiles(context.Background(), testdata.GenerateProfiles(2)))
+
+	rcv.setExportError(nil)
+
+	// Return partial success
+	rcv.setExportResponse(func() pprofileotlp.ExportResponse {
+		response := pprofileotlp.NewExportResponse()
+		partialSuccess := response.PartialSuccess()
+		partialSuccess.SetErrorMessage("Some profiles were not ingested")
+		partialSuccess.SetRejectedProfiles(1)
+
+		return response
+	})
+
+	require.NoError(t, exp.ConsumeProfiles(context.Background(), testdata.GenerateProfiles(2)))
+	assert.Len(t, observed.FilterLevelExact(zap.WarnLevel).All(), 1)
+	assert.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
+}
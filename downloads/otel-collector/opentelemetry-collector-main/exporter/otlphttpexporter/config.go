@@ -49,6 +49,9 @@ type Config struct {
 	exporterhelper.QueueConfig `mapstructure:"sending_queue"`
 	RetryConfig                configretry.BackOffConfig `mapstructure:"retry_on_failure"`
 
+	// DeadLetterConfig stores the requests that failed permanently, or ran out of retries, in a storage extension.
+	DeadLetterConfig exporterhelper.DeadLetterConfig `mapstructure:"dead_letter"`
+
 	// The URL to send traces to. If omitted the Endpoint + "/v1/traces" will be used.
 	TracesEndpoint string `mapstructure:"traces_endpoint"`
 
@@ -58,6 +61,9 @@ type Config struct {
 	// The URL to send logs to. If omitted the Endpoint + "/v1/logs" will be used.
 	LogsEndpoint string `mapstructure:"logs_endpoint"`
 
+	// The URL to send profiles to. If omitted the Endpoint + "/v1development/profiles" will be used.
+	ProfilesEndpoint string `mapstructure:"profiles_endpoint"`
+
 	// The encoding to export telemetry (default: "proto")
 	Encoding EncodingType `mapstructure:"encoding"`
 }
@@ -66,7 +72,7 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.Endpoint == "" && cfg.TracesEndpoint == "" && cfg.MetricsEndpoint == "" && cfg.LogsEndpoint == "" {
+	if cfg.Endpoint == "" && cfg.TracesEndpoint == "" && cfg.MetricsEndpoint == "" && cfg.LogsEndpoint == "" && cfg.ProfilesEndpoint == "" {
 		return errors.New("at least one endpoint must be specified")
 	}
 	return nil
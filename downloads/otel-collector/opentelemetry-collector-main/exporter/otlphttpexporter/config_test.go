@@ -19,6 +19,7 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exporterqueue"
 )
 
 func TestUnmarshalDefaultConfig(t *testing.T) {
@@ -49,6 +50,7 @@ func TestUnmarshalConfig(t *testing.T) {
 			QueueConfig: exporterhelper.QueueConfig{
 				Enabled:      true,
 				NumConsumers: 2,
+				Sizer:        exporterqueue.SizerTypeRequests,
 				QueueSize:    10,
 			},
 			Encoding: EncodingProto,
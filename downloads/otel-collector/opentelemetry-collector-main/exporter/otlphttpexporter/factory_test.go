@@ -209,19 +209,35 @@ func TestCreateLogsExporter(t *testing.T) {
 	require.NotNil(t, oexp)
 }
 
+func TestCreateProfilesExporter(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = "http://" + testutil.GetAvailableLocalAddress(t)
+
+	set := exportertest.NewNopSettings()
+	oexp, err := factory.CreateProfilesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, oexp)
+}
+
 func TestComposeSignalURL(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig().(*Config)
 
 	// Has slash at end
 	cfg.ClientConfig.Endpoint = "http://localhost:4318/"
-	url, err := composeSignalURL(cfg, "", "traces")
+	url, err := composeSignalURL(cfg, "", "traces", "v1")
 	require.NoError(t, err)
 	assert.Equal(t, "http://localhost:4318/v1/traces", url)
 
 	// No slash at end
 	cfg.ClientConfig.Endpoint = "http://localhost:4318"
-	url, err = composeSignalURL(cfg, "", "traces")
+	url, err = composeSignalURL(cfg, "", "traces", "v1")
 	require.NoError(t, err)
 	assert.Equal(t, "http://localhost:4318/v1/traces", url)
+
+	// Profiles use a development version
+	url, err = composeSignalURL(cfg, "", "profiles", "v1development")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4318/v1development/profiles", url)
 }
@@ -28,19 +28,22 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
 
 type baseExporter struct {
 	// Input configuration.
-	config     *Config
-	client     *http.Client
-	tracesURL  string
-	metricsURL string
-	logsURL    string
-	logger     *zap.Logger
-	settings   component.TelemetrySettings
+	config      *Config
+	client      *http.Client
+	tracesURL   string
+	metricsURL  string
+	logsURL     string
+	profilesURL string
+	logger      *zap.Logger
+	settings    component.TelemetrySettings
 	// Default user-agent header.
 	userAgent string
 }
@@ -149,6 +152,27 @@ func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 	return e.export(ctx, e.logsURL, request, e.logsPartialSuccessHandler)
 }
 
+func (e *baseExporter) pushProfiles(ctx context.Context, td pprofile.Profiles) error {
+	tr := pprofileotlp.NewExportRequestFromProfiles(td)
+
+	var err error
+	var request []byte
+	switch e.config.Encoding {
+	case EncodingJSON:
+		request, err = tr.MarshalJSON()
+	case EncodingProto:
+		request, err = tr.MarshalProto()
+	default:
+		err = fmt.Errorf("invalid encoding: %s", e.config.Encoding)
+	}
+
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	return e.export(ctx, e.profilesURL, request, e.profilesPartialSuccessHandler)
+}
+
 func (e *baseExporter) export(ctx context.Context, url string, request []byte, partialSuccessHandler partialSuccessHandler) error {
 	e.logger.Debug("Preparing to make HTTP request", zap.String("url", url))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(request))
@@ -179,7 +203,7 @@ func (e *baseExporter) export(ctx context.Context, url string, request []byte, p
 	}()
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
-		return handlePartialSuccessResponse(resp, partialSuccessHandler)
+		return handlePartialSuccessResponse(ctx, resp, partialSuccessHandler)
 	}
 
 	respStatus := readResponseStatus(resp)
@@ -292,18 +316,18 @@ func readResponseStatus(resp *http.Response) *status.Status {
 	return respStatus
 }
 
-func handlePartialSuccessResponse(resp *http.Response, partialSuccessHandler partialSuccessHandler) error {
+func handlePartialSuccessResponse(ctx context.Context, resp *http.Response, partialSuccessHandler partialSuccessHandler) error {
 	bodyBytes, err := readResponseBody(resp)
 	if err != nil {
 		return err
 	}
 
-	return partialSuccessHandler(bodyBytes, resp.Header.Get("Content-Type"))
+	return partialSuccessHandler(ctx, bodyBytes, resp.Header.Get("Content-Type"))
 }
 
-type partialSuccessHandler func(bytes []byte, contentType string) error
+type partialSuccessHandler func(ctx context.Context, bytes []byte, contentType string) error
 
-func (e *baseExporter) tracesPartialSuccessHandler(protoBytes []byte, contentType string) error {
+func (e *baseExporter) tracesPartialSuccessHandler(ctx context.Context, protoBytes []byte, contentType string) error {
 	if protoBytes == nil {
 		return nil
 	}
@@ -329,11 +353,12 @@ func (e *baseExporter) tracesPartialSuccessHandler(protoBytes []byte, contentTyp
 			zap.String("message", exportResponse.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_spans", exportResponse.PartialSuccess().RejectedSpans()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedSpans(), partialSuccess.ErrorMessage())
 	}
 	return nil
 }
 
-func (e *baseExporter) metricsPartialSuccessHandler(protoBytes []byte, contentType string) error {
+func (e *baseExporter) metricsPartialSuccessHandler(ctx context.Context, protoBytes []byte, contentType string) error {
 	if protoBytes == nil {
 		return nil
 	}
@@ -359,11 +384,12 @@ func (e *baseExporter) metricsPartialSuccessHandler(protoBytes []byte, contentTy
 			zap.String("message", exportResponse.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_data_points", exportResponse.PartialSuccess().RejectedDataPoints()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedDataPoints(), partialSuccess.ErrorMessage())
 	}
 	return nil
 }
 
-func (e *baseExporter) logsPartialSuccessHandler(protoBytes []byte, contentType string) error {
+func (e *baseExporter) logsPartialSuccessHandler(ctx context.Context, protoBytes []byte, contentType string) error {
 	if protoBytes == nil {
 		return nil
 	}
@@ -389,6 +415,39 @@ func (e *baseExporter) logsPartialSuccessHandler(protoBytes []byte, contentType
 			zap.String("message", exportResponse.PartialSuccess().ErrorMessage()),
 			zap.Int64("dropped_log_records", exportResponse.PartialSuccess().RejectedLogRecords()),
 		)
+		exporterhelper.ReportPartialSuccess(ctx, partialSuccess.RejectedLogRecords(), partialSuccess.ErrorMessage())
+	}
+	return nil
+}
+
+func (e *baseExporter) profilesPartialSuccessHandler(_ context.Context, protoBytes []byte, contentType string) error {
+	if protoBytes == nil {
+		return nil
+	}
+	exportResponse := pprofileotlp.NewExportResponse()
+	switch contentType {
+	case protobufContentType:
+		err := exportResponse.UnmarshalProto(protoBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing protobuf response: %w", err)
+		}
+	case jsonContentType:
+		err := exportResponse.UnmarshalJSON(protoBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing json response: %w", err)
+		}
+	default:
+		return nil
+	}
+
+	// The rejected profiles are only logged: the exporter telemetry counts the profiles in samples,
+	// and the response doesn't tell how many samples were rejected.
+	partialSuccess := exportResponse.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedProfiles() == 0) {
+		e.logger.Warn("Partial success response",
+			zap.String("message", exportResponse.PartialSuccess().ErrorMessage()),
+			zap.Int64("dropped_profiles", exportResponse.PartialSuccess().RejectedProfiles()),
+		)
 	}
 	return nil
 }
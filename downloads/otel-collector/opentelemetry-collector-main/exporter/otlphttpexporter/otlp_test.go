@@ -12,17 +12,20 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -33,6 +36,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 )
@@ -252,6 +257,65 @@ func TestErrorResponseInvalidResponseBody(t *testing.T) {
 	assert.Nil(t, status)
 }
 
+func TestAdaptiveConcurrencyThrottling(t *testing.T) {
+	tests := []struct {
+		name            string
+		responseStatus  int
+		wantConcurrency int64
+	}{
+		{
+			name:            "502",
+			responseStatus:  http.StatusBadGateway,
+			wantConcurrency: 4,
+		},
+		{
+			name:            "429",
+			responseStatus:  http.StatusTooManyRequests,
+			wantConcurrency: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests atomic.Int64
+			srv := createBackend("/v1/traces", func(writer http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				writer.WriteHeader(test.responseStatus)
+			})
+			defer srv.Close()
+
+			tt, err := componenttest.SetupTelemetry(component.MustNewID("otlphttp"))
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, tt.Shutdown(context.Background())) })
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.TracesEndpoint = fmt.Sprintf("%s/v1/traces", srv.URL)
+			cfg.RetryConfig.Enabled = false
+			cfg.QueueConfig.NumConsumers = 4
+			cfg.QueueConfig.AdaptiveConcurrency = exporterhelper.AdaptiveConcurrencyConfig{Enabled: true}
+			set := exportertest.NewNopSettings()
+			set.ID = component.MustNewID("otlphttp")
+			set.TelemetrySettings = tt.TelemetrySettings()
+			exp, err := createTracesExporter(context.Background(), set, cfg)
+			require.NoError(t, err)
+			require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+			for i := 0; i < 10; i++ {
+				require.NoError(t, exp.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+			}
+			assert.Eventually(t, func() bool {
+				return requests.Load() == 10
+			}, time.Second, 10*time.Millisecond)
+
+			dataTypeAttr := attribute.String("data_type", tracesTelemetryType)
+			assert.Eventually(t, func() bool {
+				return tt.CheckExporterMetricGauge("otelcol_exporter_queue_concurrency", test.wantConcurrency, dataTypeAttr) == nil
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
 func TestUserAgent(t *testing.T) {
 	set := exportertest.NewNopSettings()
 	set.BuildInfo.Description = "Collector"
@@ -407,7 +471,7 @@ func TestPartialSuccessInvalidBody(t *testing.T) {
 	}
 	for _, tt := range invalidBodyCases {
 		t.Run("Invalid response body_"+tt.telemetryType, func(t *testing.T) {
-			err := tt.handler([]byte{1}, "application/x-protobuf")
+			err := tt.handler(context.Background(), []byte{1}, "application/x-protobuf")
 			assert.ErrorContains(t, err, "error parsing protobuf response:")
 		})
 	}
@@ -431,7 +495,7 @@ func TestPartialSuccessUnsupportedContentType(t *testing.T) {
 	for _, telemetryType := range []string{"logs", "metrics", "traces"} {
 		for _, tt := range unsupportedContentTypeCases {
 			t.Run("Unsupported content type "+tt.contentType+" "+telemetryType, func(t *testing.T) {
-				var handler func(b []byte, contentType string) error
+				var handler func(ctx context.Context, b []byte, contentType string) error
 				switch telemetryType {
 				case "logs":
 					handler = exp.logsPartialSuccessHandler
@@ -447,7 +511,7 @@ func TestPartialSuccessUnsupportedContentType(t *testing.T) {
 				exportResponse.PartialSuccess().SetRejectedSpans(42)
 				b, err := exportResponse.MarshalProto()
 				require.NoError(t, err)
-				err = handler(b, tt.contentType)
+				err = handler(context.Background(), b, tt.contentType)
 				assert.NoError(t, err)
 			})
 		}
@@ -557,7 +621,7 @@ func TestPartialResponse_missingHeaderButHasBody(t *testing.T) {
 						"Content-Type": {ct.contentType},
 					},
 				}
-				err = handlePartialSuccessResponse(resp, tt.handler)
+				err = handlePartialSuccessResponse(context.Background(), resp, tt.handler)
 				assert.NoError(t, err)
 			})
 		}
@@ -606,7 +670,7 @@ func TestPartialResponse_missingHeaderAndBody(t *testing.T) {
 						"Content-Type": {ct.contentType},
 					},
 				}
-				err = handlePartialSuccessResponse(resp, tt.handler)
+				err = handlePartialSuccessResponse(context.Background(), resp, tt.handler)
 				assert.NoError(t, err)
 			})
 		}
@@ -624,7 +688,7 @@ func TestPartialResponse_nonErrUnexpectedEOFError(t *testing.T) {
 		ContentLength: -1,
 		Body:          io.NopCloser(badReader{}),
 	}
-	err = handlePartialSuccessResponse(resp, exp.tracesPartialSuccessHandler)
+	err = handlePartialSuccessResponse(context.Background(), resp, exp.tracesPartialSuccessHandler)
 	assert.Error(t, err)
 }
 
@@ -689,7 +753,7 @@ func TestPartialSuccess_shortContentLengthHeader(t *testing.T) {
 					},
 				}
 				// For short content-length, a real error happens.
-				err = handlePartialSuccessResponse(resp, tt.handler)
+				err = handlePartialSuccessResponse(context.Background(), resp, tt.handler)
 				assert.Error(t, err)
 			})
 		}
@@ -768,7 +832,7 @@ func TestPartialSuccess_longContentLengthHeader(t *testing.T) {
 				}
 				// No real error happens for long content length, so the partial
 				// success is handled as success with a warning.
-				err = handlePartialSuccessResponse(resp, handler)
+				err = handlePartialSuccessResponse(context.Background(), resp, handler)
 				require.NoError(t, err)
 				assert.Len(t, observed.FilterLevelExact(zap.WarnLevel).All(), 1)
 				assert.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
@@ -790,7 +854,7 @@ func TestPartialSuccessInvalidResponseBody(t *testing.T) {
 			"Content-Type": {protobufContentType},
 		},
 	}
-	err = handlePartialSuccessResponse(resp, exp.tracesPartialSuccessHandler)
+	err = handlePartialSuccessResponse(context.Background(), resp, exp.tracesPartialSuccessHandler)
 	assert.Error(t, err)
 }
 
@@ -874,6 +938,46 @@ func TestPartialSuccess_metrics(t *testing.T) {
 	require.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
 }
 
+func TestPartialSuccess_profiles(t *testing.T) {
+	srv := createBackend("/v1development/profiles", func(writer http.ResponseWriter, _ *http.Request) {
+		response := pprofileotlp.NewExportResponse()
+		partial := response.PartialSuccess()
+		partial.SetErrorMessage("hello")
+		partial.SetRejectedProfiles(1)
+		bytes, err := response.MarshalProto()
+		assert.NoError(t, err)
+		writer.Header().Set("Content-Type", "application/x-protobuf")
+		_, err = writer.Write(bytes)
+		assert.NoError(t, err)
+	})
+	defer srv.Close()
+
+	cfg := &Config{
+		Encoding:         EncodingProto,
+		ProfilesEndpoint: fmt.Sprintf("%s/v1development/profiles", srv.URL),
+		ClientConfig:     confighttp.ClientConfig{},
+	}
+	set := exportertest.NewNopSettings()
+	logger, observed := observer.New(zap.DebugLevel)
+	set.TelemetrySettings.Logger = zap.New(logger)
+	exp, err := createProfilesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+
+	// start the exporter
+	err = exp.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+
+	// generate data
+	profiles := pprofile.NewProfiles()
+	err = exp.ConsumeProfiles(context.Background(), profiles)
+	require.NoError(t, err)
+	require.Len(t, observed.FilterLevelExact(zap.WarnLevel).All(), 1)
+	require.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
+}
+
 func TestEncoding(t *testing.T) {
 	set := exportertest.NewNopSettings()
 	set.BuildInfo.Description = "Collector"
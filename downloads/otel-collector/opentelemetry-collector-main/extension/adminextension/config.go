@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adminextension // import "go.opentelemetry.io/collector/extension/adminextension"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config has the configuration for the admin extension.
+type Config struct {
+	confighttp.ServerConfig `mapstructure:",squash"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.ServerConfig.Endpoint == "" {
+		return errors.New("\"endpoint\" is required when using the \"admin\" extension")
+	}
+	if cfg.ServerConfig.Auth == nil {
+		return errors.New("\"auth\" is required when using the \"admin\" extension, since it changes the collector at runtime")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adminextension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/extension/adminextension/internal/metadata"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "custom").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: "localhost:56681",
+			Auth: &confighttp.AuthConfig{
+				Authentication: configauth.Authentication{
+					AuthenticatorID: component.MustNewID("basicauth"),
+				},
+			},
+		},
+	}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.EqualError(t, cfg.Validate(), `"auth" is required when using the "admin" extension, since it changes the collector at runtime`)
+
+	cfg.Endpoint = ""
+	assert.EqualError(t, cfg.Validate(), `"endpoint" is required when using the "admin" extension`)
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package adminextension implements an extension serving an authenticated HTTP API to change
+// the logging levels and the runtime-safe feature gates, and to reload the configuration, while the collector runs.
+package adminextension // import "go.opentelemetry.io/collector/extension/adminextension"
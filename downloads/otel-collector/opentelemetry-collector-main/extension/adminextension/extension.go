@@ -0,0 +1,361 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adminextension // import "go.opentelemetry.io/collector/extension/adminextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+const (
+	// Paths
+	logLevelsPath    = "/loglevels"
+	featureGatesPath = "/featuregates"
+	reloadPath       = "/reload"
+	deadLettersPath  = "/deadletters"
+)
+
+// logLevelsHost is implemented by the hosts whose logging levels can be changed at runtime.
+type logLevelsHost interface {
+	GetLogLevels() (zapcore.Level, map[string]zapcore.Level, error)
+	SetLogLevel(key string, level zapcore.Level) error
+	ResetLogLevel(key string) error
+}
+
+// reloadHost is implemented by the hosts which can be requested to reload the configuration.
+type reloadHost interface {
+	RequestReload() error
+}
+
+// exportersHost is implemented by the hosts which return the exporters of the pipelines.
+type exportersHost interface {
+	GetExporters() map[component.DataType]map[component.ID]component.Component
+}
+
+// deadLetterReplayer is implemented by the exporters created with exporterhelper.
+type deadLetterReplayer interface {
+	ReplayDeadLetters(ctx context.Context) (int, error)
+}
+
+type adminExtension struct {
+	config    *Config
+	telemetry component.TelemetrySettings
+	registry  *featuregate.Registry
+	logLevels logLevelsHost
+	reloader  reloadHost
+	exporters exportersHost
+	server    *http.Server
+	stopCh    chan struct{}
+}
+
+var _ extension.Extension = (*adminExtension)(nil)
+
+func newAdminExtension(config *Config, telemetry component.TelemetrySettings, registry *featuregate.Registry) *adminExtension {
+	return &adminExtension{
+		config:    config,
+		telemetry: telemetry,
+		registry:  registry,
+	}
+}
+
+func (ae *adminExtension) Start(ctx context.Context, host component.Host) error {
+	if lh, ok := host.(logLevelsHost); ok {
+		ae.logLevels = lh
+	} else {
+		ae.telemetry.Logger.Warn("The host doesn't support changing the logging levels")
+	}
+	if rh, ok := host.(reloadHost); ok {
+		ae.reloader = rh
+	} else {
+		ae.telemetry.Logger.Warn("The host doesn't support reloading the configuration")
+	}
+	if eh, ok := host.(exportersHost); ok {
+		ae.exporters = eh
+	} else {
+		ae.telemetry.Logger.Warn("The host doesn't support replaying the dead letters of the exporters")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(logLevelsPath, ae.handleLogLevels)
+	mux.HandleFunc(featureGatesPath, ae.handleFeatureGates)
+	mux.HandleFunc(reloadPath, ae.handleReload)
+	mux.HandleFunc(deadLettersPath, ae.handleDeadLetters)
+
+	// Start the listener here so we can have earlier failure if port is
+	// already in use.
+	ln, err := ae.config.ToListener(ctx)
+	if err != nil {
+		return err
+	}
+
+	ae.telemetry.Logger.Info("Starting admin extension", zap.Any("config", ae.config))
+	ae.server, err = ae.config.ToServer(ctx, host, ae.telemetry, mux)
+	if err != nil {
+		return err
+	}
+	ae.stopCh = make(chan struct{})
+	go func() {
+		defer close(ae.stopCh)
+
+		if errHTTP := ae.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+
+	return nil
+}
+
+func (ae *adminExtension) Shutdown(context.Context) error {
+	if ae.server == nil {
+		return nil
+	}
+	err := ae.server.Close()
+	if ae.stopCh != nil {
+		<-ae.stopCh
+	}
+	return err
+}
+
+// logLevels are the logging levels of the collector and of its components.
+type logLevels struct {
+	Level      zapcore.Level            `json:"level"`
+	Components map[string]zapcore.Level `json:"components"`
+}
+
+// logLevelRequest changes the level of a component, like "exporter/otlp/2", or of the collector if Component is empty.
+// An empty Level makes the component log at its configured level again, or else at the level of the collector.
+type logLevelRequest struct {
+	Component string `json:"component"`
+	Level     string `json:"level"`
+}
+
+// handleLogLevels returns the logging levels on GET, and changes a level on POST.
+func (ae *adminExtension) handleLogLevels(w http.ResponseWriter, r *http.Request) {
+	if ae.logLevels == nil {
+		http.Error(w, "the logging levels can't be changed at runtime", http.StatusNotImplemented)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var req logLevelRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := ae.setLogLevel(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	level, components, err := ae.logLevels.GetLogLevels()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+	ae.writeJSON(w, http.StatusOK, &logLevels{Level: level, Components: components})
+}
+
+func (ae *adminExtension) setLogLevel(req logLevelRequest) error {
+	if req.Level == "" {
+		if req.Component == "" {
+			return errors.New("the level of the collector can't be reset")
+		}
+		if err := ae.logLevels.ResetLogLevel(req.Component); err != nil {
+			return err
+		}
+		ae.telemetry.Logger.Info("Logging level reset", zap.String("component", req.Component))
+		return nil
+	}
+	level, err := zapcore.ParseLevel(req.Level)
+	if err != nil {
+		return err
+	}
+	if err = ae.logLevels.SetLogLevel(req.Component, level); err != nil {
+		return err
+	}
+	ae.telemetry.Logger.Info("Logging level changed", zap.String("component", req.Component), zap.Stringer("level", level))
+	return nil
+}
+
+// featureGate is a feature gate which can be toggled at runtime.
+type featureGate struct {
+	ID           string `json:"id"`
+	Description  string `json:"description"`
+	ReferenceURL string `json:"reference_url,omitempty"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// featureGateRequest enables or disables a feature gate.
+type featureGateRequest struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// handleFeatureGates returns the feature gates which can be toggled at runtime on GET, and toggles one on POST.
+// Only the alpha feature gates registered as runtime-safe can be toggled: the beta and stable ones are meant to
+// stay enabled, and the others are checked only once at startup.
+func (ae *adminExtension) handleFeatureGates(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		ae.writeJSON(w, http.StatusOK, ae.featureGates())
+	case http.MethodPost:
+		var req featureGateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+			return
+		}
+		gate, ok := ae.featureGate(req.ID)
+		if !ok {
+			http.Error(w, fmt.Sprintf("feature gate %q is not an alpha runtime-safe feature gate", req.ID), http.StatusNotFound)
+			return
+		}
+		if err := ae.registry.Set(req.ID, req.Enabled); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ae.telemetry.Logger.Info("Feature gate changed", zap.String("id", req.ID), zap.Bool("enabled", req.Enabled))
+		gate.Enabled = req.Enabled
+		ae.writeJSON(w, http.StatusOK, gate)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// featureGates returns the alpha runtime-safe feature gates, sorted by ID.
+func (ae *adminExtension) featureGates() []featureGate {
+	gates := []featureGate{}
+	ae.registry.VisitAll(func(g *featuregate.Gate) {
+		if g.Stage() == featuregate.StageAlpha && g.IsRuntimeSafe() {
+			gates = append(gates, featureGate{
+				ID:           g.ID(),
+				Description:  g.Description(),
+				ReferenceURL: g.ReferenceURL(),
+				Enabled:      g.IsEnabled(),
+			})
+		}
+	})
+	sort.Slice(gates, func(i, j int) bool { return gates[i].ID < gates[j].ID })
+	return gates
+}
+
+func (ae *adminExtension) featureGate(id string) (featureGate, bool) {
+	for _, g := range ae.featureGates() {
+		if g.ID == id {
+			return g, true
+		}
+	}
+	return featureGate{}, false
+}
+
+// handleReload requests the collector to reload its configuration on POST. The reload happens asynchronously,
+// and replaces this extension too when the extensions configuration changed.
+func (ae *adminExtension) handleReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if ae.reloader == nil {
+		http.Error(w, "the configuration reload can't be requested", http.StatusNotImplemented)
+		return
+	}
+	if err := ae.reloader.RequestReload(); err != nil {
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+	ae.telemetry.Logger.Info("Configuration reload requested")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// deadLettersRequest replays the dead letters of an exporter, like "otlp/2".
+type deadLettersRequest struct {
+	Exporter string `json:"exporter"`
+}
+
+// deadLettersReplay is the result of replaying the dead letters of an exporter for a signal.
+type deadLettersReplay struct {
+	Signal   string `json:"signal"`
+	Replayed int    `json:"replayed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// handleDeadLetters replays the dead letters of an exporter on POST, for every signal the exporter is used for.
+// The replay of a signal stops at the first request which fails again, and the error is returned with the result.
+func (ae *adminExtension) handleDeadLetters(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if ae.exporters == nil {
+		http.Error(w, "the dead letters of the exporters can't be replayed", http.StatusNotImplemented)
+		return
+	}
+	var req deadLettersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	var id component.ID
+	if err := id.UnmarshalText([]byte(req.Exporter)); err != nil {
+		http.Error(w, fmt.Sprintf("invalid exporter: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	replays := []deadLettersReplay{}
+	for dataType, exporters := range ae.exporters.GetExporters() {
+		exp, ok := exporters[id]
+		if !ok {
+			continue
+		}
+		replay := deadLettersReplay{Signal: dataType.String()}
+		replayer, ok := exp.(deadLetterReplayer)
+		if !ok {
+			replay.Error = "the exporter doesn't support dead letters"
+			replays = append(replays, replay)
+			continue
+		}
+		var err error
+		replay.Replayed, err = replayer.ReplayDeadLetters(r.Context())
+		if err != nil {
+			replay.Error = err.Error()
+		}
+		ae.telemetry.Logger.Info("Dead letters replayed", zap.String("exporter", id.String()),
+			zap.String("signal", replay.Signal), zap.Int("replayed", replay.Replayed), zap.Error(err))
+		replays = append(replays, replay)
+	}
+	if len(replays) == 0 {
+		http.Error(w, fmt.Sprintf("exporter %q is not used in any pipeline", id), http.StatusNotFound)
+		return
+	}
+	sort.Slice(replays, func(i, j int) bool { return replays[i].Signal < replays[j].Signal })
+	ae.writeJSON(w, http.StatusOK, replays)
+}
+
+func (ae *adminExtension) writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		ae.telemetry.Logger.Debug("Failed to write the admin response", zap.Error(err))
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adminextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/internal/testutil"
+)
+
+// adminHost is a host whose logging levels can be changed and which can be requested to reload.
+type adminHost struct {
+	component.Host
+	level   zapcore.Level
+	levels  map[string]zapcore.Level
+	reloads int
+}
+
+func (h *adminHost) GetLogLevels() (zapcore.Level, map[string]zapcore.Level, error) {
+	return h.level, h.levels, nil
+}
+
+func (h *adminHost) SetLogLevel(key string, level zapcore.Level) error {
+	switch key {
+	case "":
+		h.level = level
+	case "exporter/otlp":
+		h.levels[key] = level
+	default:
+		return errors.New("component " + key + " is not configured")
+	}
+	return nil
+}
+
+func (h *adminHost) ResetLogLevel(key string) error {
+	delete(h.levels, key)
+	return nil
+}
+
+func (h *adminHost) RequestReload() error {
+	h.reloads++
+	return nil
+}
+
+// pipelinesHost is a host whose pipelines use the "otlp" exporter for traces and logs,
+// and the "nop" exporter for metrics.
+type pipelinesHost struct {
+	component.Host
+	exporters map[component.DataType]map[component.ID]component.Component
+}
+
+func (h *pipelinesHost) GetExporters() map[component.DataType]map[component.ID]component.Component {
+	return h.exporters
+}
+
+// nopExporter is an exporter without dead letters.
+type nopExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+// replayerExporter is an exporter with dead letters to replay.
+type replayerExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	replayed int
+	err      error
+}
+
+func (e *replayerExporter) ReplayDeadLetters(context.Context) (int, error) {
+	return e.replayed, e.err
+}
+
+func startAdminExtension(t *testing.T, host component.Host, registry *featuregate.Registry) string {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	ext := newAdminExtension(cfg, componenttest.NewNopTelemetrySettings(), registry)
+	require.NoError(t, ext.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, ext.Shutdown(context.Background())) })
+	return "http://" + cfg.Endpoint
+}
+
+func do(t *testing.T, method, url, body string, v any) int {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode == http.StatusOK {
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+	return resp.StatusCode
+}
+
+func TestAdminExtensionLogLevels(t *testing.T) {
+	host := &adminHost{Host: componenttest.NewNopHost(), level: zapcore.InfoLevel, levels: map[string]zapcore.Level{}}
+	url := startAdminExtension(t, host, featuregate.NewRegistry()) + "/loglevels"
+
+	var levels logLevels
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, url, "", &levels))
+	assert.Equal(t, logLevels{Level: zapcore.InfoLevel, Components: map[string]zapcore.Level{}}, levels)
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"component":"exporter/otlp","level":"debug"}`, &levels))
+	assert.Equal(t, map[string]zapcore.Level{"exporter/otlp": zapcore.DebugLevel}, levels.Components)
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"level":"warn"}`, &levels))
+	assert.Equal(t, zapcore.WarnLevel, levels.Level)
+	levels = logLevels{}
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"component":"exporter/otlp"}`, &levels))
+	assert.Empty(t, levels.Components)
+
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{"component":"receiver/otlp","level":"debug"}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{"component":"exporter/otlp","level":"verbose"}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{`, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodDelete, url, "", nil))
+}
+
+func TestAdminExtensionFeatureGates(t *testing.T) {
+	registry := featuregate.NewRegistry()
+	registry.MustRegister("alpha.safe", featuregate.StageAlpha, featuregate.WithRegisterDescription("Safe gate"), featuregate.WithRegisterRuntimeSafe())
+	registry.MustRegister("alpha.unsafe", featuregate.StageAlpha)
+	registry.MustRegister("beta.safe", featuregate.StageBeta, featuregate.WithRegisterRuntimeSafe())
+	url := startAdminExtension(t, componenttest.NewNopHost(), registry) + "/featuregates"
+
+	var gates []featureGate
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, url, "", &gates))
+	assert.Equal(t, []featureGate{{ID: "alpha.safe", Description: "Safe gate"}}, gates)
+
+	var gate featureGate
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"id":"alpha.safe","enabled":true}`, &gate))
+	assert.Equal(t, featureGate{ID: "alpha.safe", Description: "Safe gate", Enabled: true}, gate)
+
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodPost, url, `{"id":"alpha.unsafe","enabled":true}`, nil))
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodPost, url, `{"id":"beta.safe","enabled":false}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{`, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodPut, url, "", nil))
+
+	registry.VisitAll(func(g *featuregate.Gate) {
+		assert.Equal(t, g.ID() != "alpha.unsafe", g.IsEnabled(), g.ID())
+	})
+}
+
+func TestAdminExtensionReload(t *testing.T) {
+	host := &adminHost{Host: componenttest.NewNopHost()}
+	url := startAdminExtension(t, host, featuregate.NewRegistry()) + "/reload"
+
+	assert.Equal(t, http.StatusAccepted, do(t, http.MethodPost, url, "", nil))
+	assert.Equal(t, 1, host.reloads)
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodGet, url, "", nil))
+}
+
+func TestAdminExtensionDeadLetters(t *testing.T) {
+	otlpID := component.MustNewID("otlp")
+	nopID := component.MustNewID("nop")
+	host := &pipelinesHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[component.DataType]map[component.ID]component.Component{
+			component.DataTypeTraces:  {otlpID: &replayerExporter{replayed: 3}},
+			component.DataTypeLogs:    {otlpID: &replayerExporter{replayed: 1, err: errors.New("connection refused")}},
+			component.DataTypeMetrics: {nopID: &nopExporter{}},
+		},
+	}
+	url := startAdminExtension(t, host, featuregate.NewRegistry()) + "/deadletters"
+
+	var replays []deadLettersReplay
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"exporter":"otlp"}`, &replays))
+	assert.Equal(t, []deadLettersReplay{
+		{Signal: "logs", Replayed: 1, Error: "connection refused"},
+		{Signal: "traces", Replayed: 3},
+	}, replays)
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, url, `{"exporter":"nop"}`, &replays))
+	assert.Equal(t, []deadLettersReplay{{Signal: "metrics", Error: "the exporter doesn't support dead letters"}}, replays)
+
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodPost, url, `{"exporter":"otlp/2"}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{"exporter":""}`, nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, `{`, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodGet, url, "", nil))
+}
+
+func TestAdminExtensionUnsupportedHost(t *testing.T) {
+	url := startAdminExtension(t, componenttest.NewNopHost(), featuregate.NewRegistry())
+
+	assert.Equal(t, http.StatusNotImplemented, do(t, http.MethodGet, url+"/loglevels", "", nil))
+	assert.Equal(t, http.StatusNotImplemented, do(t, http.MethodPost, url+"/reload", "", nil))
+	assert.Equal(t, http.StatusNotImplemented, do(t, http.MethodPost, url+"/deadletters", `{"exporter":"otlp"}`, nil))
+}
+
+func TestAdminExtensionPortAlreadyInUse(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	first := newAdminExtension(cfg, componenttest.NewNopTelemetrySettings(), featuregate.NewRegistry())
+	require.NoError(t, first.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, first.Shutdown(context.Background())) })
+
+	second := newAdminExtension(cfg, componenttest.NewNopTelemetrySettings(), featuregate.NewRegistry())
+	require.Error(t, second.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, second.Shutdown(context.Background()))
+}
@@ -0,0 +1,16 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	Type      = component.MustNewType("admin")
+	ScopeName = "go.opentelemetry.io/collector/extension/adminextension"
+)
+
+const (
+	ExtensionStability = component.StabilityLevelDevelopment
+)
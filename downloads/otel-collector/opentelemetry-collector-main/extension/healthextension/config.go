@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension // import "go.opentelemetry.io/collector/extension/healthextension"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config has the configuration for the health extension.
+type Config struct {
+	confighttp.ServerConfig `mapstructure:",squash"`
+
+	// LivenessPath is the path of the liveness endpoint.
+	LivenessPath string `mapstructure:"liveness_path"`
+
+	// ReadinessPath is the path of the readiness endpoint.
+	ReadinessPath string `mapstructure:"readiness_path"`
+
+	// ComponentHealth are the rules deciding whether the status of a component is healthy.
+	ComponentHealth ComponentHealthConfig `mapstructure:"component_health"`
+}
+
+// ComponentHealthConfig are the rules deciding whether the status of a component is healthy.
+// The components reporting a fatal error are always unhealthy.
+type ComponentHealthConfig struct {
+	// IncludePermanentErrors makes the components reporting a permanent error unhealthy.
+	IncludePermanentErrors bool `mapstructure:"include_permanent_errors"`
+
+	// IncludeRecoverableErrors makes the components reporting a recoverable error unhealthy,
+	// once the error is older than RecoveryDuration.
+	IncludeRecoverableErrors bool `mapstructure:"include_recoverable_errors"`
+
+	// RecoveryDuration is how long a component can report a recoverable error before it is unhealthy.
+	RecoveryDuration time.Duration `mapstructure:"recovery_duration"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.ServerConfig.Endpoint == "" {
+		return errors.New("\"endpoint\" is required when using the \"health\" extension")
+	}
+	if !strings.HasPrefix(cfg.LivenessPath, "/") || !strings.HasPrefix(cfg.ReadinessPath, "/") {
+		return errors.New("\"liveness_path\" and \"readiness_path\" must start with \"/\"")
+	}
+	if cfg.LivenessPath == cfg.ReadinessPath {
+		return errors.New("\"liveness_path\" and \"readiness_path\" must be different")
+	}
+	if cfg.ComponentHealth.RecoveryDuration < 0 {
+		return errors.New("\"component_health::recovery_duration\" must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/extension/healthextension/internal/metadata"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:56888",
+				},
+				LivenessPath:  "/livez",
+				ReadinessPath: "/readyz",
+				ComponentHealth: ComponentHealthConfig{
+					IncludePermanentErrors:   false,
+					IncludeRecoverableErrors: true,
+					RecoveryDuration:         30 * time.Second,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name:   "no endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "" },
+			err:    `"endpoint" is required when using the "health" extension`,
+		},
+		{
+			name:   "relative path",
+			modify: func(cfg *Config) { cfg.LivenessPath = "live" },
+			err:    `"liveness_path" and "readiness_path" must start with "/"`,
+		},
+		{
+			name:   "same paths",
+			modify: func(cfg *Config) { cfg.ReadinessPath = cfg.LivenessPath },
+			err:    `"liveness_path" and "readiness_path" must be different`,
+		},
+		{
+			name:   "negative recovery duration",
+			modify: func(cfg *Config) { cfg.ComponentHealth.RecoveryDuration = -time.Second },
+			err:    `"component_health::recovery_duration" must not be negative`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package healthextension implements an extension that aggregates the status reported by the components,
+// and serves the liveness and readiness of the collector over HTTP.
+package healthextension // import "go.opentelemetry.io/collector/extension/healthextension"
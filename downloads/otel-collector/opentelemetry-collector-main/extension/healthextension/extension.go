@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension // import "go.opentelemetry.io/collector/extension/healthextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/extensioncapabilities"
+)
+
+type healthExtension struct {
+	config     *Config
+	telemetry  component.TelemetrySettings
+	aggregator *aggregator
+	ready      atomic.Bool
+	server     *http.Server
+	stopCh     chan struct{}
+	now        func() time.Time
+}
+
+var _ extension.Extension = (*healthExtension)(nil)
+var _ componentstatus.Watcher = (*healthExtension)(nil)
+var _ extensioncapabilities.PipelineWatcher = (*healthExtension)(nil)
+
+func newHealthExtension(config *Config, telemetry component.TelemetrySettings) *healthExtension {
+	return &healthExtension{
+		config:     config,
+		telemetry:  telemetry,
+		aggregator: newAggregator(config.ComponentHealth),
+		now:        time.Now,
+	}
+}
+
+func (he *healthExtension) Start(ctx context.Context, host component.Host) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(he.config.LivenessPath, he.handleLiveness)
+	mux.HandleFunc(he.config.ReadinessPath, he.handleReadiness)
+
+	// Start the listener here so we can have earlier failure if port is
+	// already in use.
+	ln, err := he.config.ToListener(ctx)
+	if err != nil {
+		return err
+	}
+
+	he.telemetry.Logger.Info("Starting health extension", zap.Any("config", he.config))
+	he.server, err = he.config.ToServer(ctx, host, he.telemetry, mux)
+	if err != nil {
+		return err
+	}
+	he.stopCh = make(chan struct{})
+	go func() {
+		defer close(he.stopCh)
+
+		if errHTTP := he.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+
+	return nil
+}
+
+func (he *healthExtension) Shutdown(context.Context) error {
+	if he.server == nil {
+		return nil
+	}
+	err := he.server.Close()
+	if he.stopCh != nil {
+		<-he.stopCh
+	}
+	return err
+}
+
+// ComponentStatusChanged records the status events of all the components.
+func (he *healthExtension) ComponentStatusChanged(source *componentstatus.InstanceID, event *componentstatus.Event) {
+	he.aggregator.record(source, event)
+}
+
+// Ready marks the collector as ready once the pipelines are started.
+func (he *healthExtension) Ready() error {
+	he.ready.Store(true)
+	return nil
+}
+
+// NotReady marks the collector as not ready once the pipelines are about to be shut down.
+func (he *healthExtension) NotReady() error {
+	he.ready.Store(false)
+	return nil
+}
+
+// handleLiveness responds with 200 while the collector is healthy, and 503 otherwise.
+func (he *healthExtension) handleLiveness(w http.ResponseWriter, _ *http.Request) {
+	ch := he.health()
+	he.writeHealth(w, ch, ch.Healthy)
+}
+
+// handleReadiness responds with 200 while the pipelines are started and the collector is healthy, and 503 otherwise.
+func (he *healthExtension) handleReadiness(w http.ResponseWriter, _ *http.Request) {
+	ch := he.health()
+	he.writeHealth(w, ch, ch.Ready && ch.Healthy)
+}
+
+func (he *healthExtension) health() *collectorHealth {
+	ch := he.aggregator.health(he.now())
+	ch.Ready = he.ready.Load()
+	return ch
+}
+
+func (he *healthExtension) writeHealth(w http.ResponseWriter, ch *collectorHealth, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(ch); err != nil {
+		he.telemetry.Logger.Debug("Failed to write the health response", zap.Error(err))
+	}
+}
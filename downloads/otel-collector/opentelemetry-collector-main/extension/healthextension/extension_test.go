@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/internal/testutil"
+)
+
+func TestHealthExtensionUsage(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	cfg.ComponentHealth.RecoveryDuration = time.Minute
+
+	ext := newHealthExtension(cfg, componenttest.NewNopTelemetrySettings())
+	now := time.Now()
+	ext.now = func() time.Time { return now }
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, ext.Shutdown(context.Background())) })
+
+	get := func(path string) (int, *collectorHealth) {
+		resp, err := http.Get("http://" + cfg.Endpoint + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		var ch collectorHealth
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&ch))
+		return resp.StatusCode, &ch
+	}
+
+	ext.ComponentStatusChanged(receiverID, componentstatus.NewEvent(componentstatus.StatusStarting))
+	ext.ComponentStatusChanged(exporterID, componentstatus.NewEvent(componentstatus.StatusStarting))
+
+	// The collector is alive but not ready while the pipelines are starting.
+	code, ch := get("/health/live")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, ch.Healthy)
+	assert.False(t, ch.Ready)
+	assert.Equal(t, componentstatus.StatusStarting.String(), ch.Status)
+	code, _ = get("/health/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	ext.ComponentStatusChanged(receiverID, componentstatus.NewEvent(componentstatus.StatusOK))
+	ext.ComponentStatusChanged(exporterID, componentstatus.NewEvent(componentstatus.StatusOK))
+	require.NoError(t, ext.Ready())
+
+	code, ch = get("/health/ready")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, ch.Ready)
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Pipelines["traces"].Components["exporter:debug"].Status)
+
+	// The recoverable error is unhealthy once it is older than the recovery duration.
+	ext.ComponentStatusChanged(exporterID, componentstatus.NewRecoverableErrorEvent(errors.New("connection refused")))
+	code, _ = get("/health/live")
+	assert.Equal(t, http.StatusOK, code)
+	now = now.Add(2 * time.Minute)
+	code, ch = get("/health/live")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, ch.Healthy)
+	assert.False(t, ch.Pipelines["traces"].Healthy)
+	assert.Equal(t, "connection refused", ch.Pipelines["traces"].Components["exporter:debug"].Error)
+	code, _ = get("/health/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	// The collector is no longer ready once the pipelines are shutting down.
+	ext.ComponentStatusChanged(exporterID, componentstatus.NewEvent(componentstatus.StatusOK))
+	require.NoError(t, ext.NotReady())
+	code, ch = get("/health/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.True(t, ch.Healthy)
+	assert.False(t, ch.Ready)
+}
+
+func TestHealthExtensionPortAlreadyInUse(t *testing.T) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	ln, err := net.Listen("tcp", endpoint)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	ext := newHealthExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.Error(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+}
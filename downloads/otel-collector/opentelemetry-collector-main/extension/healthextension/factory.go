@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension // import "go.opentelemetry.io/collector/extension/healthextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/healthextension/internal/metadata"
+)
+
+const (
+	defaultEndpoint         = "localhost:13133"
+	defaultLivenessPath     = "/health/live"
+	defaultReadinessPath    = "/health/ready"
+	defaultRecoveryDuration = time.Minute
+)
+
+// NewFactory creates a factory for the health extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(metadata.Type, createDefaultConfig, createExtension, metadata.ExtensionStability)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: defaultEndpoint,
+		},
+		LivenessPath:  defaultLivenessPath,
+		ReadinessPath: defaultReadinessPath,
+		ComponentHealth: ComponentHealthConfig{
+			IncludePermanentErrors:   true,
+			IncludeRecoverableErrors: true,
+			RecoveryDuration:         defaultRecoveryDuration,
+		},
+	}
+}
+
+// createExtension creates the extension based on this config.
+func createExtension(_ context.Context, set extension.Settings, cfg component.Config) (extension.Extension, error) {
+	return newHealthExtension(cfg.(*Config), set.TelemetrySettings), nil
+}
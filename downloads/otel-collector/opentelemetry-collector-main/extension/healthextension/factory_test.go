@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func TestFactory_CreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	assert.Equal(t, &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: "localhost:13133",
+		},
+		LivenessPath:  "/health/live",
+		ReadinessPath: "/health/ready",
+		ComponentHealth: ComponentHealthConfig{
+			IncludePermanentErrors:   true,
+			IncludeRecoverableErrors: true,
+			RecoveryDuration:         time.Minute,
+		},
+	}, cfg)
+
+	require.NoError(t, componenttest.CheckConfigStruct(cfg))
+	ext, err := createExtension(context.Background(), extensiontest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+}
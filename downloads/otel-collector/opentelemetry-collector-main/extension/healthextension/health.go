@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension // import "go.opentelemetry.io/collector/extension/healthextension"
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+// statusPriority orders the statuses from the least to the most severe,
+// the status of a pipeline or of the collector is the most severe status of its components.
+var statusPriority = map[componentstatus.Status]int{
+	componentstatus.StatusNone:             0,
+	componentstatus.StatusOK:               1,
+	componentstatus.StatusStarting:         2,
+	componentstatus.StatusStopped:          3,
+	componentstatus.StatusStopping:         4,
+	componentstatus.StatusRecoverableError: 5,
+	componentstatus.StatusPermanentError:   6,
+	componentstatus.StatusFatalError:       7,
+}
+
+// collectorHealth is the health of the collector, served as JSON.
+type collectorHealth struct {
+	Healthy    bool                        `json:"healthy"`
+	Ready      bool                        `json:"ready"`
+	Status     string                      `json:"status"`
+	Pipelines  map[string]*pipelineHealth  `json:"pipelines"`
+	Extensions map[string]*componentHealth `json:"extensions"`
+}
+
+// pipelineHealth is the health of a pipeline, aggregated from the health of its components.
+type pipelineHealth struct {
+	Healthy bool   `json:"healthy"`
+	Status  string `json:"status"`
+	// Components are keyed by kind and ID, like "receiver:otlp".
+	Components map[string]*componentHealth `json:"components"`
+}
+
+// componentHealth is the health of a component, from the last status event it reported.
+type componentHealth struct {
+	Healthy    bool      `json:"healthy"`
+	Status     string    `json:"status"`
+	StatusTime time.Time `json:"status_time"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// aggregator keeps the last status event of each component instance,
+// and aggregates them into the health of the pipelines and of the collector.
+type aggregator struct {
+	rules ComponentHealthConfig
+
+	mu     sync.Mutex
+	events map[*componentstatus.InstanceID]*componentstatus.Event
+}
+
+func newAggregator(rules ComponentHealthConfig) *aggregator {
+	return &aggregator{
+		rules:  rules,
+		events: make(map[*componentstatus.InstanceID]*componentstatus.Event),
+	}
+}
+
+// record records the status event reported by the component instance. The
+// stopped instances are forgotten: the instances replaced by a reload report
+// StatusStopped and must not hide the status of the instances replacing them.
+func (a *aggregator) record(source *componentstatus.InstanceID, event *componentstatus.Event) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if event.Status() == componentstatus.StatusStopped {
+		delete(a.events, source)
+		return
+	}
+	a.events[source] = event
+}
+
+// health returns the health of the collector at the given time.
+func (a *aggregator) health(now time.Time) *collectorHealth {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch := &collectorHealth{
+		Healthy:    true,
+		Pipelines:  make(map[string]*pipelineHealth),
+		Extensions: make(map[string]*componentHealth),
+	}
+	collectorStatus := componentstatus.StatusNone
+	pipelineStatuses := make(map[string]componentstatus.Status)
+	for source, event := range a.events {
+		comp := a.componentHealth(event, now)
+		ch.Healthy = ch.Healthy && comp.Healthy
+		collectorStatus = worst(collectorStatus, event.Status())
+
+		if source.Kind() == component.KindExtension {
+			ch.Extensions[source.ComponentID().String()] = comp
+			continue
+		}
+		key := strings.ToLower(source.Kind().String()) + ":" + source.ComponentID().String()
+		source.AllPipelineIDs(func(id component.ID) bool {
+			ph, ok := ch.Pipelines[id.String()]
+			if !ok {
+				ph = &pipelineHealth{Healthy: true, Components: make(map[string]*componentHealth)}
+				ch.Pipelines[id.String()] = ph
+			}
+			ph.Healthy = ph.Healthy && comp.Healthy
+			ph.Components[key] = comp
+			pipelineStatuses[id.String()] = worst(pipelineStatuses[id.String()], event.Status())
+			return true
+		})
+	}
+	for id, ph := range ch.Pipelines {
+		ph.Status = pipelineStatuses[id].String()
+	}
+	ch.Status = collectorStatus.String()
+	return ch
+}
+
+// componentHealth applies the rules to the status event of a component.
+// The fatal errors are always unhealthy, the permanent errors when they are included,
+// and the recoverable errors when they are included and not recovered within the recovery duration.
+func (a *aggregator) componentHealth(event *componentstatus.Event, now time.Time) *componentHealth {
+	comp := &componentHealth{
+		Healthy:    true,
+		Status:     event.Status().String(),
+		StatusTime: event.Timestamp(),
+	}
+	if err := event.Err(); err != nil {
+		comp.Error = err.Error()
+	}
+	switch event.Status() {
+	case componentstatus.StatusFatalError:
+		comp.Healthy = false
+	case componentstatus.StatusPermanentError:
+		comp.Healthy = !a.rules.IncludePermanentErrors
+	case componentstatus.StatusRecoverableError:
+		comp.Healthy = !a.rules.IncludeRecoverableErrors || now.Sub(event.Timestamp()) < a.rules.RecoveryDuration
+	}
+	return comp
+}
+
+// worst returns the most severe of the statuses.
+func worst(s1, s2 componentstatus.Status) componentstatus.Status {
+	if statusPriority[s2] > statusPriority[s1] {
+		return s2
+	}
+	return s1
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthextension
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+)
+
+var (
+	tracesID  = component.MustNewID("traces")
+	metricsID = component.MustNewID("metrics")
+
+	receiverID  = componentstatus.NewInstanceID(component.MustNewID("otlp"), component.KindReceiver, tracesID, metricsID)
+	exporterID  = componentstatus.NewInstanceID(component.MustNewID("debug"), component.KindExporter, tracesID)
+	exporter2ID = componentstatus.NewInstanceID(component.MustNewIDWithName("debug", "2"), component.KindExporter, metricsID)
+	extensionID = componentstatus.NewInstanceID(component.MustNewID("zpages"), component.KindExtension)
+)
+
+func TestAggregatorHealth(t *testing.T) {
+	now := time.Now()
+	a := newAggregator(ComponentHealthConfig{IncludePermanentErrors: true, IncludeRecoverableErrors: true, RecoveryDuration: time.Minute})
+
+	ch := a.health(now)
+	assert.True(t, ch.Healthy)
+	assert.Equal(t, componentstatus.StatusNone.String(), ch.Status)
+	assert.Empty(t, ch.Pipelines)
+	assert.Empty(t, ch.Extensions)
+
+	a.record(receiverID, componentstatus.NewEvent(componentstatus.StatusOK))
+	a.record(exporterID, componentstatus.NewEvent(componentstatus.StatusOK))
+	a.record(exporter2ID, componentstatus.NewRecoverableErrorEvent(errors.New("connection refused")))
+	a.record(extensionID, componentstatus.NewEvent(componentstatus.StatusOK))
+
+	ch = a.health(now)
+	assert.True(t, ch.Healthy)
+	assert.Equal(t, componentstatus.StatusRecoverableError.String(), ch.Status)
+	assert.Len(t, ch.Pipelines, 2)
+	assert.True(t, ch.Pipelines["traces"].Healthy)
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Pipelines["traces"].Status)
+	assert.Equal(t, []string{"exporter:debug", "receiver:otlp"}, keys(ch.Pipelines["traces"].Components))
+	assert.True(t, ch.Pipelines["metrics"].Healthy)
+	assert.Equal(t, componentstatus.StatusRecoverableError.String(), ch.Pipelines["metrics"].Status)
+	assert.Equal(t, []string{"exporter:debug/2", "receiver:otlp"}, keys(ch.Pipelines["metrics"].Components))
+	assert.Equal(t, "connection refused", ch.Pipelines["metrics"].Components["exporter:debug/2"].Error)
+	assert.Equal(t, []string{"zpages"}, keys(ch.Extensions))
+
+	// The recoverable error is unhealthy once it is older than the recovery duration.
+	ch = a.health(now.Add(2 * time.Minute))
+	assert.False(t, ch.Healthy)
+	assert.True(t, ch.Pipelines["traces"].Healthy)
+	assert.False(t, ch.Pipelines["metrics"].Healthy)
+	assert.False(t, ch.Pipelines["metrics"].Components["exporter:debug/2"].Healthy)
+	assert.True(t, ch.Pipelines["metrics"].Components["receiver:otlp"].Healthy)
+
+	// The component recovers.
+	a.record(exporter2ID, componentstatus.NewEvent(componentstatus.StatusOK))
+	ch = a.health(now.Add(2 * time.Minute))
+	assert.True(t, ch.Healthy)
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Status)
+}
+
+func TestAggregatorHealthReload(t *testing.T) {
+	now := time.Now()
+	a := newAggregator(ComponentHealthConfig{})
+
+	a.record(receiverID, componentstatus.NewEvent(componentstatus.StatusOK))
+	a.record(exporterID, componentstatus.NewEvent(componentstatus.StatusOK))
+
+	// The reload replaces the exporter with a new instance of the same component.
+	newExporterID := componentstatus.NewInstanceID(component.MustNewID("debug"), component.KindExporter, tracesID)
+	a.record(exporterID, componentstatus.NewEvent(componentstatus.StatusStopping))
+	a.record(exporterID, componentstatus.NewEvent(componentstatus.StatusStopped))
+	a.record(newExporterID, componentstatus.NewEvent(componentstatus.StatusStarting))
+	a.record(newExporterID, componentstatus.NewEvent(componentstatus.StatusOK))
+
+	ch := a.health(now)
+	assert.True(t, ch.Healthy)
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Status)
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Pipelines["traces"].Status)
+	assert.Equal(t, []string{"exporter:debug", "receiver:otlp"}, keys(ch.Pipelines["traces"].Components))
+	assert.Equal(t, componentstatus.StatusOK.String(), ch.Pipelines["traces"].Components["exporter:debug"].Status)
+
+	// The pipeline removed by the reload is no longer reported.
+	a.record(receiverID, componentstatus.NewEvent(componentstatus.StatusStopped))
+	a.record(newExporterID, componentstatus.NewEvent(componentstatus.StatusStopped))
+	ch = a.health(now)
+	assert.Equal(t, componentstatus.StatusNone.String(), ch.Status)
+	assert.Empty(t, ch.Pipelines)
+}
+
+func TestComponentHealthRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   ComponentHealthConfig
+		event   *componentstatus.Event
+		healthy bool
+	}{
+		{
+			name:    "ok",
+			event:   componentstatus.NewEvent(componentstatus.StatusOK),
+			healthy: true,
+		},
+		{
+			name:    "starting",
+			event:   componentstatus.NewEvent(componentstatus.StatusStarting),
+			healthy: true,
+		},
+		{
+			name:    "fatal error",
+			event:   componentstatus.NewFatalErrorEvent(errors.New("err")),
+			healthy: false,
+		},
+		{
+			name:    "permanent error excluded",
+			event:   componentstatus.NewPermanentErrorEvent(errors.New("err")),
+			healthy: true,
+		},
+		{
+			name:    "permanent error included",
+			rules:   ComponentHealthConfig{IncludePermanentErrors: true},
+			event:   componentstatus.NewPermanentErrorEvent(errors.New("err")),
+			healthy: false,
+		},
+		{
+			name:    "recoverable error excluded",
+			event:   componentstatus.NewRecoverableErrorEvent(errors.New("err")),
+			healthy: true,
+		},
+		{
+			name:    "recoverable error within recovery duration",
+			rules:   ComponentHealthConfig{IncludeRecoverableErrors: true, RecoveryDuration: time.Hour},
+			event:   componentstatus.NewRecoverableErrorEvent(errors.New("err")),
+			healthy: true,
+		},
+		{
+			name:    "recoverable error without recovery duration",
+			rules:   ComponentHealthConfig{IncludeRecoverableErrors: true},
+			event:   componentstatus.NewRecoverableErrorEvent(errors.New("err")),
+			healthy: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := newAggregator(tt.rules).componentHealth(tt.event, tt.event.Timestamp())
+			assert.Equal(t, tt.healthy, comp.Healthy)
+			assert.Equal(t, tt.event.Status().String(), comp.Status)
+			assert.Equal(t, tt.event.Timestamp(), comp.StatusTime)
+		})
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
@@ -13,6 +13,18 @@ import (
 // Config has the configuration for the extension enabling the zPages extension.
 type Config struct {
 	confighttp.ServerConfig `mapstructure:",squash"`
+
+	// Tap configures the tapz zPage, streaming the data sent from a component of the pipelines to another.
+	Tap TapConfig `mapstructure:"tap"`
+}
+
+// TapConfig configures the tapz zPage.
+type TapConfig struct {
+	// Enabled registers the tapz zPage. It is disabled by default, since it exposes the data of the pipelines.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxItems is the maximum number of batches of data a request to the tapz zPage can capture.
+	MaxItems int `mapstructure:"max_items"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -22,5 +34,8 @@ func (cfg *Config) Validate() error {
 	if cfg.ServerConfig.Endpoint == "" {
 		return errors.New("\"endpoint\" is required when using the \"zpages\" extension")
 	}
+	if cfg.Tap.Enabled && cfg.Tap.MaxItems < 1 {
+		return errors.New("\"tap::max_items\" must be positive when the tap is enabled")
+	}
 	return nil
 }
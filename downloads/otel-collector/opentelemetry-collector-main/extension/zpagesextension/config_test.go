@@ -24,6 +24,10 @@ func TestUnmarshalDefaultConfig(t *testing.T) {
 
 func TestInvalidConfig(t *testing.T) {
 	assert.Error(t, (&Config{}).Validate())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Tap = TapConfig{Enabled: true}
+	assert.EqualError(t, cfg.Validate(), `"tap::max_items" must be positive when the tap is enabled`)
 }
 
 func TestUnmarshalConfig(t *testing.T) {
@@ -37,5 +41,9 @@ func TestUnmarshalConfig(t *testing.T) {
 			ServerConfig: confighttp.ServerConfig{
 				Endpoint: "localhost:56888",
 			},
+			Tap: TapConfig{
+				Enabled:  true,
+				MaxItems: 20,
+			},
 		}, cfg)
 }
@@ -13,7 +13,8 @@ import (
 )
 
 const (
-	defaultEndpoint = "localhost:55679"
+	defaultEndpoint    = "localhost:55679"
+	defaultTapMaxItems = 100
 )
 
 // NewFactory creates a factory for Z-Pages extension.
@@ -26,6 +27,9 @@ func createDefaultConfig() component.Config {
 		ServerConfig: confighttp.ServerConfig{
 			Endpoint: defaultEndpoint,
 		},
+		Tap: TapConfig{
+			MaxItems: defaultTapMaxItems,
+		},
 	}
 }
 
@@ -22,6 +22,9 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 		ServerConfig: confighttp.ServerConfig{
 			Endpoint: "localhost:55679",
 		},
+		Tap: TapConfig{
+			MaxItems: 100,
+		},
 	},
 		cfg)
 
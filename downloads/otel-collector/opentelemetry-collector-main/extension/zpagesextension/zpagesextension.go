@@ -67,6 +67,18 @@ func (zpe *zpagesExtension) Start(ctx context.Context, host component.Host) erro
 		zpe.telemetry.Logger.Warn("Host's zPages not available")
 	}
 
+	if zpe.config.Tap.Enabled {
+		hostTap, ok := host.(interface {
+			RegisterTapZPage(mux *http.ServeMux, pathPrefix string, maxItems int)
+		})
+		if ok {
+			hostTap.RegisterTapZPage(zPagesMux, "/debug", zpe.config.Tap.MaxItems)
+			zpe.telemetry.Logger.Info("Registered Host's tap zPage")
+		} else {
+			zpe.telemetry.Logger.Warn("Host's tap zPage not available")
+		}
+	}
+
 	// Start the listener here so we can have earlier failure if port is
 	// already in use.
 	ln, err := zpe.config.ToListener(ctx)
@@ -111,6 +123,12 @@ func (zpe *zpagesExtension) Shutdown(context.Context) error {
 	return err
 }
 
+// TapZPageEnabled tells the host whether the extension registers the tapz zPage, so the host only attaches the
+// taps to the edges of the pipelines when the zPage is enabled.
+func (zpe *zpagesExtension) TapZPageEnabled() bool {
+	return zpe.config.Tap.Enabled
+}
+
 func newServer(config *Config, telemetry component.TelemetrySettings) *zpagesExtension {
 	return &zpagesExtension{
 		config:              config,
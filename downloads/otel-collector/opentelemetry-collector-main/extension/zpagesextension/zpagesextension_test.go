@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
@@ -23,6 +24,7 @@ import (
 
 type zpagesHost struct {
 	component.Host
+	tapMaxItems int
 }
 
 func newZPagesHost() *zpagesHost {
@@ -31,6 +33,11 @@ func newZPagesHost() *zpagesHost {
 
 func (*zpagesHost) RegisterZPages(*http.ServeMux, string) {}
 
+func (h *zpagesHost) RegisterTapZPage(mux *http.ServeMux, pathPrefix string, maxItems int) {
+	h.tapMaxItems = maxItems
+	mux.HandleFunc(pathPrefix+"/tapz", func(http.ResponseWriter, *http.Request) {})
+}
+
 var _ registerableTracerProvider = (*registerableProvider)(nil)
 var _ registerableTracerProvider = sdktrace.NewTracerProvider()
 
@@ -49,7 +56,7 @@ func newZpagesTelemetrySettings() component.TelemetrySettings {
 
 func TestZPagesExtensionUsage(t *testing.T) {
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: testutil.GetAvailableLocalAddress(t),
 		},
 	}
@@ -74,9 +81,40 @@ func TestZPagesExtensionUsage(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestZPagesExtensionTap(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		cfg := &Config{
+			ServerConfig: confighttp.ServerConfig{
+				Endpoint: testutil.GetAvailableLocalAddress(t),
+			},
+			Tap: TapConfig{
+				Enabled:  enabled,
+				MaxItems: 5,
+			},
+		}
+		host := newZPagesHost()
+		zpagesExt := newServer(cfg, newZpagesTelemetrySettings())
+		assert.Equal(t, enabled, zpagesExt.TapZPageEnabled())
+		require.NoError(t, zpagesExt.Start(context.Background(), host))
+
+		resp, err := http.Get("http://" + cfg.ServerConfig.Endpoint + "/debug/tapz")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.NoError(t, zpagesExt.Shutdown(context.Background()))
+
+		if enabled {
+			assert.Equal(t, 5, host.tapMaxItems)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		} else {
+			assert.Zero(t, host.tapMaxItems)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		}
+	}
+}
+
 func TestZPagesExtensionBadAuthExtension(t *testing.T) {
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: "localhost:0",
 			Auth: &confighttp.AuthConfig{
 				Authentication: configauth.Authentication{
@@ -96,7 +134,7 @@ func TestZPagesExtensionPortAlreadyInUse(t *testing.T) {
 	defer ln.Close()
 
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: endpoint,
 		},
 	}
@@ -108,7 +146,7 @@ func TestZPagesExtensionPortAlreadyInUse(t *testing.T) {
 
 func TestZPagesMultipleStarts(t *testing.T) {
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: testutil.GetAvailableLocalAddress(t),
 		},
 	}
@@ -125,7 +163,7 @@ func TestZPagesMultipleStarts(t *testing.T) {
 
 func TestZPagesMultipleShutdowns(t *testing.T) {
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: testutil.GetAvailableLocalAddress(t),
 		},
 	}
@@ -140,7 +178,7 @@ func TestZPagesMultipleShutdowns(t *testing.T) {
 
 func TestZPagesShutdownWithoutStart(t *testing.T) {
 	cfg := &Config{
-		confighttp.ServerConfig{
+		ServerConfig: confighttp.ServerConfig{
 			Endpoint: testutil.GetAvailableLocalAddress(t),
 		},
 	}
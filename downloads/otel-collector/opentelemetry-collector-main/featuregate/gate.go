@@ -19,6 +19,7 @@ type Gate struct {
 	fromVersion  *version.Version
 	toVersion    *version.Version
 	stage        Stage
+	runtimeSafe  bool
 	enabled      *atomic.Bool
 }
 
@@ -56,3 +57,8 @@ func (g *Gate) FromVersion() string {
 func (g *Gate) ToVersion() string {
 	return fmt.Sprintf("v%s", g.toVersion)
 }
+
+// IsRuntimeSafe returns true if the Gate can be enabled or disabled while the collector is running.
+func (g *Gate) IsRuntimeSafe() bool {
+	return g.runtimeSafe
+}
@@ -28,6 +28,7 @@ func TestGate(t *testing.T) {
 		referenceURL: "http://example.com",
 		fromVersion:  from,
 		toVersion:    to,
+		runtimeSafe:  true,
 	}
 
 	assert.Equal(t, "test", g.ID())
@@ -37,4 +38,5 @@ func TestGate(t *testing.T) {
 	assert.Equal(t, "http://example.com", g.ReferenceURL())
 	assert.Equal(t, "v0.61.0", g.FromVersion())
 	assert.Equal(t, "v0.64.0", g.ToVersion())
+	assert.True(t, g.IsRuntimeSafe())
 }
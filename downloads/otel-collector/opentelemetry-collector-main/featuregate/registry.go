@@ -107,6 +107,15 @@ func WithRegisterToVersion(toVersion string) RegisterOption {
 	})
 }
 
+// WithRegisterRuntimeSafe marks the Gate as safe to be enabled or disabled while the collector is running,
+// because the feature checks the Gate each time it is used instead of once at startup.
+func WithRegisterRuntimeSafe() RegisterOption {
+	return registerOptionFunc(func(g *Gate) error {
+		g.runtimeSafe = true
+		return nil
+	})
+}
+
 // MustRegister like Register but panics if an invalid ID or gate options are provided.
 func (r *Registry) MustRegister(id string, stage Stage, opts ...RegisterOption) *Gate {
 	g, err := r.Register(id, stage, opts...)
@@ -67,6 +67,12 @@ func TestRegistryApply(t *testing.T) {
 	assert.True(t, fooGate.IsEnabled())
 }
 
+func TestRegisterRuntimeSafe(t *testing.T) {
+	r := NewRegistry()
+	assert.False(t, r.MustRegister("foo", StageAlpha).IsRuntimeSafe())
+	assert.True(t, r.MustRegister("bar", StageAlpha, WithRegisterRuntimeSafe()).IsRuntimeSafe())
+}
+
 func TestRegisterGateLifecycle(t *testing.T) {
 	for _, tc := range []struct {
 		name      string
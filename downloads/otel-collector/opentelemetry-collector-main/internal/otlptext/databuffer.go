@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package otlptext // import "go.opentelemetry.io/collector/exporter/internal/otlptext"
+package otlptext // import "go.opentelemetry.io/collector/internal/otlptext"
 
 import (
 	"bytes"
@@ -11,6 +11,7 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -280,6 +281,226 @@ func (b *dataBuffer) logExemplars(description string, se pmetric.ExemplarSlice)
 	}
 }
 
+func (b *dataBuffer) logValueTypes(description string, vts pprofile.ValueTypeSlice, strs pcommon.StringSlice) {
+	if vts.Len() == 0 {
+		return
+	}
+
+	b.logEntry("%s:", description)
+	for i := 0; i < vts.Len(); i++ {
+		b.logEntry("     -> %s", valueTypeToString(vts.At(i), strs))
+	}
+}
+
+func (b *dataBuffer) logProfileSamples(profile pprofile.Profile) {
+	ss := profile.Sample()
+	if ss.Len() == 0 {
+		return
+	}
+
+	strs := profile.StringTable()
+	attrs := attributeTableEntries(profile.AttributeTable())
+	b.logEntry("Samples:")
+	for i := 0; i < ss.Len(); i++ {
+		s := ss.At(i)
+		b.logEntry("Sample #%d", i)
+		b.logEntry("     -> Location index: %v", s.LocationIndex().AsRaw())
+		b.logEntry("     -> Locations start index: %d", s.LocationsStartIndex())
+		b.logEntry("     -> Locations length: %d", s.LocationsLength())
+		b.logEntry("     -> Stacktrace ID index: %d", s.StacktraceIdIndex())
+		b.logEntry("     -> Values: %v", s.Value().AsRaw())
+		b.logIndexedAttributes("     -> Attributes", s.Attributes(), attrs)
+		b.logEntry("     -> Link: %d", s.Link())
+		if s.TimestampsUnixNano().Len() > 0 {
+			b.logEntry("     -> Timestamps: %v", s.TimestampsUnixNano().AsRaw())
+		}
+
+		labels := s.Label()
+		for j := 0; j < labels.Len(); j++ {
+			l := labels.At(j)
+			if l.Str() != 0 {
+				b.logEntry("     -> Label %s: %s", stringAt(strs, l.Key()), stringAt(strs, l.Str()))
+				continue
+			}
+			b.logEntry("     -> Label %s: %d %s", stringAt(strs, l.Key()), l.Num(), stringAt(strs, l.NumUnit()))
+		}
+	}
+}
+
+func (b *dataBuffer) logProfileMappings(profile pprofile.Profile) {
+	ms := profile.Mapping()
+	if ms.Len() == 0 {
+		return
+	}
+
+	strs := profile.StringTable()
+	attrs := attributeTableEntries(profile.AttributeTable())
+	b.logEntry("Mappings:")
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		b.logEntry("Mapping #%d", i)
+		b.logEntry("     -> ID: %d", m.ID())
+		b.logEntry("     -> Memory start: %#x", m.MemoryStart())
+		b.logEntry("     -> Memory limit: %#x", m.MemoryLimit())
+		b.logEntry("     -> File offset: %#x", m.FileOffset())
+		b.logEntry("     -> Filename: %s", stringAt(strs, m.Filename()))
+		b.logEntry("     -> Build ID: %s", stringAt(strs, m.BuildID()))
+		b.logEntry("     -> Build ID kind: %s", m.BuildIDKind())
+		b.logIndexedAttributes("     -> Attributes", m.Attributes(), attrs)
+		b.logEntry("     -> Has functions: %t", m.HasFunctions())
+		b.logEntry("     -> Has filenames: %t", m.HasFilenames())
+		b.logEntry("     -> Has line numbers: %t", m.HasLineNumbers())
+		b.logEntry("     -> Has inline frames: %t", m.HasInlineFrames())
+	}
+}
+
+func (b *dataBuffer) logProfileLocations(profile pprofile.Profile) {
+	ls := profile.Location()
+	if ls.Len() == 0 {
+		return
+	}
+
+	strs := profile.StringTable()
+	fns := profile.Function()
+	attrs := attributeTableEntries(profile.AttributeTable())
+	b.logEntry("Locations:")
+	for i := 0; i < ls.Len(); i++ {
+		l := ls.At(i)
+		b.logEntry("Location #%d", i)
+		b.logEntry("     -> ID: %d", l.ID())
+		b.logEntry("     -> Mapping index: %d", l.MappingIndex())
+		b.logEntry("     -> Address: %#x", l.Address())
+		b.logEntry("     -> Is folded: %t", l.IsFolded())
+		b.logEntry("     -> Type: %s", stringAt(strs, int64(l.TypeIndex())))
+		b.logIndexedAttributes("     -> Attributes", l.Attributes(), attrs)
+
+		lines := l.Line()
+		for j := 0; j < lines.Len(); j++ {
+			line := lines.At(j)
+			if line.FunctionIndex() >= uint64(fns.Len()) {
+				b.logEntry("     -> Line #%d: <invalid function index %d> %d:%d", j, line.FunctionIndex(), line.Line(), line.Column())
+				continue
+			}
+			fn := fns.At(int(line.FunctionIndex()))
+			b.logEntry("     -> Line #%d: %s (%s:%d:%d)", j, stringAt(strs, fn.Name()), stringAt(strs, fn.Filename()), line.Line(), line.Column())
+		}
+	}
+}
+
+func (b *dataBuffer) logProfileFunctions(profile pprofile.Profile) {
+	fs := profile.Function()
+	if fs.Len() == 0 {
+		return
+	}
+
+	strs := profile.StringTable()
+	b.logEntry("Functions:")
+	for i := 0; i < fs.Len(); i++ {
+		f := fs.At(i)
+		b.logEntry("Function #%d", i)
+		b.logEntry("     -> ID: %d", f.ID())
+		b.logEntry("     -> Name: %s", stringAt(strs, f.Name()))
+		b.logEntry("     -> System name: %s", stringAt(strs, f.SystemName()))
+		b.logEntry("     -> Filename: %s", stringAt(strs, f.Filename()))
+		b.logEntry("     -> Start line: %d", f.StartLine())
+	}
+}
+
+func (b *dataBuffer) logProfileAttributeUnits(profile pprofile.Profile) {
+	aus := profile.AttributeUnits()
+	if aus.Len() == 0 {
+		return
+	}
+
+	strs := profile.StringTable()
+	b.logEntry("Attribute units:")
+	for i := 0; i < aus.Len(); i++ {
+		au := aus.At(i)
+		b.logEntry("     -> %s: %s", stringAt(strs, au.AttributeKey()), stringAt(strs, au.Unit()))
+	}
+}
+
+func (b *dataBuffer) logProfileLinks(ls pprofile.LinkSlice) {
+	if ls.Len() == 0 {
+		return
+	}
+
+	b.logEntry("Link table:")
+	for i := 0; i < ls.Len(); i++ {
+		l := ls.At(i)
+		b.logEntry("Link #%d", i)
+		b.logEntry("     -> Trace ID: %s", l.TraceID())
+		b.logEntry("     -> Span ID: %s", l.SpanID())
+	}
+}
+
+func (b *dataBuffer) logStringTable(strs pcommon.StringSlice) {
+	if strs.Len() == 0 {
+		return
+	}
+
+	b.logEntry("String table:")
+	for i := 0; i < strs.Len(); i++ {
+		b.logEntry("     -> %d: %q", i, strs.At(i))
+	}
+}
+
+func (b *dataBuffer) logStrings(description string, indices pcommon.Int64Slice, strs pcommon.StringSlice) {
+	if indices.Len() == 0 {
+		return
+	}
+
+	b.logEntry("%s:", description)
+	for i := 0; i < indices.Len(); i++ {
+		b.logEntry("     -> %s", stringAt(strs, indices.At(i)))
+	}
+}
+
+// logIndexedAttributes logs the entries of the profile attribute table referenced by indices.
+func (b *dataBuffer) logIndexedAttributes(header string, indices pcommon.UInt64Slice, attrs []string) {
+	if indices.Len() == 0 {
+		return
+	}
+
+	resolved := make([]string, 0, indices.Len())
+	for i := 0; i < indices.Len(); i++ {
+		idx := indices.At(i)
+		if idx >= uint64(len(attrs)) {
+			resolved = append(resolved, fmt.Sprintf("<invalid attribute index %d>", idx))
+			continue
+		}
+		resolved = append(resolved, attrs[idx])
+	}
+	b.logEntry("%s: %s", header, strings.Join(resolved, ", "))
+}
+
+// attributeTableEntries returns the entries of the profile attribute table in order,
+// so they can be looked up by the indices used by samples, mappings and locations.
+func attributeTableEntries(m pcommon.Map) []string {
+	entries := make([]string, 0, m.Len())
+	m.Range(func(k string, v pcommon.Value) bool {
+		entries = append(entries, fmt.Sprintf("%s=%s", k, valueToString(v)))
+		return true
+	})
+	return entries
+}
+
+// stringAt resolves an index of the profile string table.
+// The first entry of the table is always the empty string, so it may be omitted.
+func stringAt(strs pcommon.StringSlice, idx int64) string {
+	if idx == 0 && strs.Len() == 0 {
+		return ""
+	}
+	if idx < 0 || idx >= int64(strs.Len()) {
+		return fmt.Sprintf("<invalid string index %d>", idx)
+	}
+	return strs.At(int(idx))
+}
+
+func valueTypeToString(vt pprofile.ValueType, strs pcommon.StringSlice) string {
+	return fmt.Sprintf("%s (%s), AggregationTemporality: %s", stringAt(strs, vt.Type()), stringAt(strs, vt.Unit()), vt.AggregationTemporality())
+}
+
 func valueToString(v pcommon.Value) string {
 	return fmt.Sprintf("%s(%s)", v.Type().String(), v.AsString())
 }
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptext // import "go.opentelemetry.io/collector/internal/otlptext"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// NewTextProfilesMarshaler returns a pprofile.Marshaler to encode to OTLP text bytes.
+func NewTextProfilesMarshaler() pprofile.Marshaler {
+	return textProfilesMarshaler{}
+}
+
+type textProfilesMarshaler struct{}
+
+// MarshalProfiles pprofile.Profiles to OTLP text.
+func (textProfilesMarshaler) MarshalProfiles(pd pprofile.Profiles) ([]byte, error) {
+	buf := dataBuffer{}
+	rps := pd.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		buf.logEntry("ResourceProfiles #%d", i)
+		rp := rps.At(i)
+		buf.logEntry("Resource SchemaURL: %s", rp.SchemaUrl())
+		buf.logAttributes("Resource attributes", rp.Resource().Attributes())
+		sps := rp.ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			buf.logEntry("ScopeProfiles #%d", j)
+			sp := sps.At(j)
+			buf.logEntry("ScopeProfiles SchemaURL: %s", sp.SchemaUrl())
+			buf.logInstrumentationScope(sp.Scope())
+
+			profiles := sp.Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				buf.logEntry("Profile #%d", k)
+				pc := profiles.At(k)
+				buf.logEntry("Profile ID: %s", pc.ProfileID())
+				buf.logEntry("Start time: %s", pc.StartTime())
+				buf.logEntry("End time: %s", pc.EndTime())
+				buf.logAttributes("Attributes", pc.Attributes())
+				buf.logEntry("Dropped attributes count: %d", pc.DroppedAttributesCount())
+
+				profile := pc.Profile()
+				strs := profile.StringTable()
+				buf.logValueTypes("Sample types", profile.SampleType(), strs)
+				buf.logEntry("Period type: %s", valueTypeToString(profile.PeriodType(), strs))
+				buf.logEntry("Period: %d", profile.Period())
+				buf.logEntry("Default sample type: %s", stringAt(strs, profile.DefaultSampleType()))
+				buf.logEntry("Drop frames: %s", stringAt(strs, profile.DropFrames()))
+				buf.logEntry("Keep frames: %s", stringAt(strs, profile.KeepFrames()))
+				buf.logEntry("Location indices: %v", profile.LocationIndices().AsRaw())
+				buf.logProfileSamples(profile)
+				buf.logProfileMappings(profile)
+				buf.logProfileLocations(profile)
+				buf.logProfileFunctions(profile)
+				buf.logAttributes("Attribute table", profile.AttributeTable())
+				buf.logProfileAttributeUnits(profile)
+				buf.logProfileLinks(profile.LinkTable())
+				buf.logStringTable(strs)
+				buf.logStrings("Comments", profile.Comment(), strs)
+			}
+		}
+	}
+
+	return buf.buf.Bytes(), nil
+}
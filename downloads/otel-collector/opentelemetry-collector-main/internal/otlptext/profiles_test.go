@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptext
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestProfilesText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   pprofile.Profiles
+		out  string
+	}{
+		{
+			name: "empty_profiles",
+			in:   pprofile.NewProfiles(),
+			out:  "empty.out",
+		},
+		{
+			name: "two_profiles",
+			in:   testdata.GenerateProfiles(2),
+			out:  "two_profiles.out",
+		},
+		{
+			name: "profile_with_lookup_tables",
+			in: func() pprofile.Profiles {
+				pd := pprofile.NewProfiles()
+				pc := pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+				pc.SetProfileID([16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10})
+				pc.SetStartTime(pcommon.NewTimestampFromTime(time.Date(2020, 2, 11, 20, 26, 12, 321, time.UTC)))
+				pc.SetEndTime(pcommon.NewTimestampFromTime(time.Date(2020, 2, 11, 20, 26, 13, 789, time.UTC)))
+
+				profile := pc.Profile()
+				profile.StringTable().Append("", "samples", "count", "cpu", "nanoseconds", "main", "main.go", "/usr/bin/app", "abc123", "thread", "worker", "comment")
+				st := profile.SampleType().AppendEmpty()
+				st.SetType(1)
+				st.SetUnit(2)
+				profile.PeriodType().SetType(3)
+				profile.PeriodType().SetUnit(4)
+				profile.SetPeriod(1000000)
+				profile.Comment().Append(11)
+
+				profile.AttributeTable().PutStr("host.name", "localhost")
+				au := profile.AttributeUnits().AppendEmpty()
+				au.SetAttributeKey(3)
+				au.SetUnit(4)
+
+				fn := profile.Function().AppendEmpty()
+				fn.SetID(1)
+				fn.SetName(5)
+				fn.SetSystemName(5)
+				fn.SetFilename(6)
+				fn.SetStartLine(10)
+
+				m := profile.Mapping().AppendEmpty()
+				m.SetID(1)
+				m.SetMemoryStart(0x1000)
+				m.SetMemoryLimit(0x2000)
+				m.SetFilename(7)
+				m.SetBuildID(8)
+				m.SetHasFunctions(true)
+
+				loc := profile.Location().AppendEmpty()
+				loc.SetID(1)
+				loc.SetAddress(0x1234)
+				loc.Attributes().Append(0)
+				line := loc.Line().AppendEmpty()
+				line.SetFunctionIndex(0)
+				line.SetLine(42)
+				profile.LocationIndices().Append(0)
+
+				link := profile.LinkTable().AppendEmpty()
+				link.SetTraceID([16]byte{0x08, 0x04, 0x02, 0x01})
+				link.SetSpanID([8]byte{0x01, 0x02, 0x04, 0x08})
+
+				s := profile.Sample().AppendEmpty()
+				s.LocationIndex().Append(0)
+				s.SetLocationsLength(1)
+				s.Value().Append(7)
+				s.Attributes().Append(0, 3)
+				label := s.Label().AppendEmpty()
+				label.SetKey(9)
+				label.SetStr(10)
+				return pd
+			}(),
+			out: "lookup_tables.out",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTextProfilesMarshaler().MarshalProfiles(tt.in)
+			require.NoError(t, err)
+			out, err := os.ReadFile(filepath.Join("testdata", "profiles", tt.out))
+			require.NoError(t, err)
+			expected := strings.ReplaceAll(string(out), "\r", "")
+			assert.Equal(t, expected, string(got))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"reflect"
 	"sync/atomic"
 	"syscall"
 
@@ -74,6 +75,11 @@ type CollectorSettings struct {
 
 	// SkipSettingGRPCLogger avoids setting the grpc logger
 	SkipSettingGRPCLogger bool
+
+	// ConfigDescriptions holds the doc comments of the config structs and of their fields, keyed by their Go path,
+	// like "go.opentelemetry.io/collector/exporter/otlpexporter.Config.Timeout". They are generated by the builder
+	// and describe the settings in the output of the schema command.
+	ConfigDescriptions map[string]string
 }
 
 // (Internal note) Collector Lifecycle:
@@ -93,6 +99,7 @@ type Collector struct {
 
 	configProvider ConfigProvider
 
+	cfg           *Config
 	serviceConfig *service.Config
 	service       *service.Service
 	state         *atomic.Int32
@@ -102,7 +109,9 @@ type Collector struct {
 	// signalsChannel is used to receive termination signals from the OS.
 	signalsChannel chan os.Signal
 	// asyncErrorChannel is used to signal a fatal error from any component.
-	asyncErrorChannel          chan error
+	asyncErrorChannel chan error
+	// reloadChannel is used by the components to request a reload of the configuration.
+	reloadChannel              chan struct{}
 	bc                         *bufferedCore
 	updateConfigProviderLogger func(core zapcore.Core)
 }
@@ -131,6 +140,7 @@ func NewCollector(set CollectorSettings) (*Collector, error) {
 		// the number of signals getting notified on is recommended.
 		signalsChannel:             make(chan os.Signal, 3),
 		asyncErrorChannel:          make(chan error),
+		reloadChannel:              make(chan struct{}, 1),
 		configProvider:             configProvider,
 		bc:                         bc,
 		updateConfigProviderLogger: cc.SetCore,
@@ -159,52 +169,40 @@ func (col *Collector) Shutdown() {
 func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	col.setCollectorState(StateStarting)
 
+	factories, cfg, err := col.loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return col.startService(ctx, factories, cfg)
+}
+
+// loadConfig gets and validates the current configuration.
+func (col *Collector) loadConfig(ctx context.Context) (Factories, *Config, error) {
 	factories, err := col.set.Factories()
 	if err != nil {
-		return fmt.Errorf("failed to initialize factories: %w", err)
+		return Factories{}, nil, fmt.Errorf("failed to initialize factories: %w", err)
 	}
 	cfg, err := col.configProvider.Get(ctx, factories)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return Factories{}, nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
-	if err = cfg.Validate(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
+	if err = col.validateConfig(cfg); err != nil {
+		return Factories{}, nil, fmt.Errorf("invalid configuration: %w", err)
 	}
+	return factories, cfg, nil
+}
 
+// startService creates and starts the service of the given configuration.
+func (col *Collector) startService(ctx context.Context, factories Factories, cfg *Config) error {
+	col.cfg = cfg
 	col.serviceConfig = &cfg.Service
 
-	conf := confmap.New()
-
-	if err = conf.Marshal(cfg); err != nil {
-		return fmt.Errorf("could not marshal configuration: %w", err)
+	set, err := col.serviceSettings(factories, cfg)
+	if err != nil {
+		return err
 	}
-
-	col.service, err = service.New(ctx, service.Settings{
-		BuildInfo:     col.set.BuildInfo,
-		CollectorConf: conf,
-
-		ReceiversConfigs:    cfg.Receivers,
-		ReceiversFactories:  factories.Receivers,
-		ProcessorsConfigs:   cfg.Processors,
-		ProcessorsFactories: factories.Processors,
-		ExportersConfigs:    cfg.Exporters,
-		ExportersFactories:  factories.Exporters,
-		ConnectorsConfigs:   cfg.Connectors,
-		ConnectorsFactories: factories.Connectors,
-		ExtensionsConfigs:   cfg.Extensions,
-		ExtensionsFactories: factories.Extensions,
-
-		ModuleInfo: extension.ModuleInfo{
-			Receiver:  factories.ReceiverModules,
-			Processor: factories.ProcessorModules,
-			Exporter:  factories.ExporterModules,
-			Extension: factories.ExtensionModules,
-			Connector: factories.ConnectorModules,
-		},
-		AsyncErrorChannel: col.asyncErrorChannel,
-		LoggingOptions:    col.set.LoggingOptions,
-	}, cfg.Service)
+	col.service, err = service.New(ctx, set, cfg.Service)
 	if err != nil {
 		return err
 	}
@@ -233,7 +231,57 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	return nil
 }
 
+func (col *Collector) serviceSettings(factories Factories, cfg *Config) (service.Settings, error) {
+	conf := confmap.New()
+
+	if err := conf.Marshal(cfg); err != nil {
+		return service.Settings{}, fmt.Errorf("could not marshal configuration: %w", err)
+	}
+
+	return service.Settings{
+		BuildInfo:     col.set.BuildInfo,
+		CollectorConf: conf,
+
+		ReceiversConfigs:    cfg.Receivers,
+		ReceiversFactories:  factories.Receivers,
+		ProcessorsConfigs:   cfg.Processors,
+		ProcessorsFactories: factories.Processors,
+		ExportersConfigs:    cfg.Exporters,
+		ExportersFactories:  factories.Exporters,
+		ConnectorsConfigs:   cfg.Connectors,
+		ConnectorsFactories: factories.Connectors,
+		ExtensionsConfigs:   cfg.Extensions,
+		ExtensionsFactories: factories.Extensions,
+
+		ModuleInfo: extension.ModuleInfo{
+			Receiver:  factories.ReceiverModules,
+			Processor: factories.ProcessorModules,
+			Exporter:  factories.ExporterModules,
+			Extension: factories.ExtensionModules,
+			Connector: factories.ConnectorModules,
+		},
+		AsyncErrorChannel: col.asyncErrorChannel,
+		ReloadChannel:     col.reloadChannel,
+		LoggingOptions:    col.set.LoggingOptions,
+	}, nil
+}
+
 func (col *Collector) reloadConfiguration(ctx context.Context) error {
+	factories, cfg, loadErr := col.loadConfig(ctx)
+	if loadErr == nil && onlyPipelinesChanged(col.cfg, cfg) {
+		col.service.Logger().Warn("Config updated, reload pipelines")
+		set, err := col.serviceSettings(factories, cfg)
+		if err == nil {
+			err = col.service.Reload(ctx, set, cfg.Service)
+		}
+		if err == nil {
+			col.cfg = cfg
+			col.serviceConfig = &cfg.Service
+			return nil
+		}
+		col.service.Logger().Error("Failed to reload pipelines", zap.Error(err))
+	}
+
 	col.service.Logger().Warn("Config updated, restart service")
 	col.setCollectorState(StateClosing)
 
@@ -241,24 +289,55 @@ func (col *Collector) reloadConfiguration(ctx context.Context) error {
 		return fmt.Errorf("failed to shutdown the retiring config: %w", err)
 	}
 
-	if err := col.setupConfigurationComponents(ctx); err != nil {
+	col.setCollectorState(StateStarting)
+	if loadErr != nil {
+		return fmt.Errorf("failed to setup configuration components: %w", loadErr)
+	}
+	if err := col.startService(ctx, factories, cfg); err != nil {
 		return fmt.Errorf("failed to setup configuration components: %w", err)
 	}
 
 	return nil
 }
 
+// onlyPipelinesChanged returns whether the telemetry and the extensions are unchanged between the configurations,
+// in which case the changes can be applied by only replacing the affected pipeline components.
+func onlyPipelinesChanged(prev, next *Config) bool {
+	return prev != nil &&
+		reflect.DeepEqual(prev.Extensions, next.Extensions) &&
+		reflect.DeepEqual(prev.Service.Extensions, next.Service.Extensions) &&
+		reflect.DeepEqual(prev.Service.Telemetry, next.Service.Telemetry)
+}
+
 func (col *Collector) DryRun(ctx context.Context) error {
+	_, _, err := col.dryRun(ctx)
+	return err
+}
+
+// dryRun loads and validates the configuration like DryRun, and returns it with the factories it was unmarshaled with.
+func (col *Collector) dryRun(ctx context.Context) (Factories, *Config, error) {
 	factories, err := col.set.Factories()
 	if err != nil {
-		return fmt.Errorf("failed to initialize factories: %w", err)
+		return Factories{}, nil, fmt.Errorf("failed to initialize factories: %w", err)
 	}
 	cfg, err := col.configProvider.Get(ctx, factories)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return Factories{}, nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
-	return cfg.Validate()
+	return factories, cfg, col.validateConfig(cfg)
+}
+
+// validateConfig validates the config, adding to the errors where the invalid settings were set
+// when the configuration was resolved by the ConfigProvider of the collector.
+func (col *Collector) validateConfig(cfg *Config) error {
+	var conf *confmap.Conf
+	if cp, ok := col.configProvider.(*configProvider); ok {
+		conf = cp.conf
+	}
+	return cfg.validate(func(key string, err error) error {
+		return withOrigins(conf, key, err)
+	})
 }
 
 func newFallbackLogger(options []zap.Option) (*zap.Logger, error) {
@@ -332,6 +411,11 @@ LOOP:
 			if err := col.reloadConfiguration(ctx); err != nil {
 				return err
 			}
+		case <-col.reloadChannel:
+			col.service.Logger().Info("Received reload request")
+			if err := col.reloadConfiguration(ctx); err != nil {
+				return err
+			}
 		case <-col.shutdownChan:
 			col.service.Logger().Info("Received shutdown request")
 			break LOOP
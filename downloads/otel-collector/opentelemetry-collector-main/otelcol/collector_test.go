@@ -9,6 +9,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"syscall"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/yaml.v3"
 
 	"go.opentelemetry.io/collector/component"
@@ -116,6 +118,63 @@ func TestCollectorStateAfterConfigChange(t *testing.T) {
 	assert.Equal(t, StateClosed, col.GetState())
 }
 
+func TestCollectorReloadPipelines(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	copyFile := func(src string) {
+		content, err := os.ReadFile(filepath.Join("testdata", src))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(cfgFile, content, 0600))
+	}
+	copyFile("otelcol-nop.yaml")
+
+	var mu sync.Mutex
+	var messages []string
+	hook := zap.Hooks(func(entry zapcore.Entry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, entry.Message)
+		return nil
+	})
+	logged := func(msg string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Contains(messages, msg)
+	}
+
+	watcher := make(chan error, 1)
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:              component.NewDefaultBuildInfo(),
+		Factories:              nopFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{cfgFile}),
+		LoggingOptions:         []zap.Option{hook},
+	})
+	require.NoError(t, err)
+	provider, err := NewConfigProvider(newDefaultConfigProviderSettings(t, []string{cfgFile}))
+	require.NoError(t, err)
+	col.configProvider = &mockCfgProvider{ConfigProvider: provider, watcher: watcher}
+
+	wg := startCollector(context.Background(), t, col)
+
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+
+	// Only the pipelines changed, the service keeps running.
+	copyFile("otelcol-nop-pipelines.yaml")
+	watcher <- nil
+
+	assert.Eventually(t, func() bool {
+		return logged("Pipelines reloaded.")
+	}, 2*time.Second, 200*time.Millisecond)
+	assert.False(t, logged("Config updated, restart service"))
+	assert.Equal(t, StateRunning, col.GetState())
+
+	col.Shutdown()
+
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+}
+
 func TestCollectorReportError(t *testing.T) {
 	col, err := NewCollector(CollectorSettings{
 		BuildInfo:              component.NewDefaultBuildInfo(),
@@ -278,6 +337,31 @@ func TestCollectorSendSignal(t *testing.T) {
 	assert.Equal(t, StateClosed, col.GetState())
 }
 
+func TestCollectorReloadRequest(t *testing.T) {
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:              component.NewDefaultBuildInfo(),
+		Factories:              nopFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-nop.yaml")}),
+	})
+	require.NoError(t, err)
+
+	wg := startCollector(context.Background(), t, col)
+
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+
+	col.reloadChannel <- struct{}{}
+
+	assert.Eventually(t, func() bool {
+		return len(col.reloadChannel) == 0 && StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+
+	col.Shutdown()
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+}
+
 func TestCollectorFailedShutdown(t *testing.T) {
 	t.Skip("This test was using telemetry shutdown failure, switch to use a component that errors on shutdown.")
 
@@ -459,7 +543,7 @@ func TestCollectorDryRun(t *testing.T) {
 				Factories:              nopFactories,
 				ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-invalid.yaml")}),
 			},
-			expectedErr: `service::pipelines::traces: references processor "invalid" which is not configured`,
+			expectedErr: `service::pipelines::traces: references processor "invalid" which is not configured (set in file:testdata/otelcol-invalid.yaml)`,
 		},
 	}
 
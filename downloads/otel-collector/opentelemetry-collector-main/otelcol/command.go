@@ -37,7 +37,9 @@ func NewCommand(set CollectorSettings) *cobra.Command {
 		},
 	}
 	rootCmd.AddCommand(newComponentsCommand(set))
+	rootCmd.AddCommand(newSchemaCommand(set))
 	rootCmd.AddCommand(newValidateSubCommand(set, flagSet))
+	rootCmd.AddCommand(newPrintConfigSubCommand(set, flagSet))
 	rootCmd.Flags().AddGoFlagSet(flagSet)
 	return rootCmd
 }
@@ -54,6 +56,10 @@ func updateSettingsUsingFlags(set *CollectorSettings, flags *flag.FlagSet) error
 		return errors.New("at least one config flag must be provided")
 	}
 
+	if mergeMode := getMergeModeFlag(flags); mergeMode != "" {
+		resolverSet.MergeMode = mergeMode
+	}
+
 	if set.ConfigProviderSettings.ResolverSettings.DefaultScheme == "" {
 		set.ConfigProviderSettings.ResolverSettings.DefaultScheme = "env"
 	}
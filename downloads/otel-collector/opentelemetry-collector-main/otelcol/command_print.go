@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+const (
+	printModeRedacted   = "redacted"
+	printModeUnredacted = "unredacted"
+
+	printFormatYAML = "yaml"
+	printFormatJSON = "json"
+
+	// redactedValue is the value the configopaque.String fields are marshaled as.
+	redactedValue = "[REDACTED]"
+)
+
+// newPrintConfigSubCommand constructs a new print-config sub command using the given CollectorSettings.
+func newPrintConfigSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var mode, format string
+	printConfigCmd := &cobra.Command{
+		Use:   "print-config",
+		Short: "Prints the effective config without running the collector",
+		Long: "Prints the config resolved from the config URIs and merged into the default config of the components, " +
+			"as the collector would run it. The values of the sensitive settings, like passwords and tokens, are redacted " +
+			"unless --mode=unredacted is set, in which case the sensitive settings not set in the config stay redacted. " +
+			"The output format is not stable and can change between releases.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if mode != printModeRedacted && mode != printModeUnredacted {
+				return fmt.Errorf("invalid mode %q, must be %q or %q", mode, printModeRedacted, printModeUnredacted)
+			}
+			if format != printFormatYAML && format != printFormatJSON {
+				return fmt.Errorf("invalid format %q, must be %q or %q", format, printFormatYAML, printFormatJSON)
+			}
+			if err := updateSettingsUsingFlags(&set, flagSet); err != nil {
+				return err
+			}
+			return printConfig(cmd.Context(), set, mode, format, cmd.OutOrStdout())
+		},
+	}
+	printConfigCmd.Flags().StringVar(&mode, "mode", printModeRedacted,
+		"Whether the sensitive values are redacted: \"redacted\" or \"unredacted\". The unredacted output can contain secrets.")
+	printConfigCmd.Flags().StringVar(&format, "format", printFormatYAML, "The output format: \"yaml\" or \"json\".")
+	printConfigCmd.Flags().AddGoFlagSet(flagSet)
+	return printConfigCmd
+}
+
+func printConfig(ctx context.Context, set CollectorSettings, mode, format string, out io.Writer) (errs error) {
+	factories, err := set.Factories()
+	if err != nil {
+		return fmt.Errorf("failed to initialize factories: %w", err)
+	}
+
+	resolver, err := confmap.NewResolver(set.ConfigProviderSettings.ResolverSettings)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errs = multierr.Append(errs, resolver.Shutdown(ctx))
+	}()
+
+	conf, err := resolver.Resolve(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot resolve the configuration: %w", err)
+	}
+	cfg, err := unmarshal(conf, factories)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal the configuration: %w", err)
+	}
+
+	effective := confmap.New()
+	if err = effective.Marshal(&Config{
+		Receivers:  cfg.Receivers.Configs(),
+		Processors: cfg.Processors.Configs(),
+		Exporters:  cfg.Exporters.Configs(),
+		Connectors: cfg.Connectors.Configs(),
+		Extensions: cfg.Extensions.Configs(),
+		Service:    cfg.Service,
+	}); err != nil {
+		return fmt.Errorf("could not marshal configuration: %w", err)
+	}
+
+	var printed any = effective.ToStringMap()
+	if mode == printModeUnredacted {
+		printed = unredact(printed, conf.ToStringMap())
+	}
+
+	var data []byte
+	switch format {
+	case printFormatJSON:
+		if data, err = json.MarshalIndent(durationsToStrings(printed), "", "  "); err == nil {
+			data = append(data, '\n')
+		}
+	default:
+		data, err = yaml.Marshal(printed)
+	}
+	if err != nil {
+		return fmt.Errorf("could not encode configuration: %w", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// unredact replaces the redacted values of the effective configuration with the resolved ones at the same path.
+// The resolved configuration contains the values as they were set by the user, before being unmarshaled.
+func unredact(effective, resolved any) any {
+	switch e := effective.(type) {
+	case string:
+		if e != redactedValue || resolved == nil {
+			return e
+		}
+		switch r := resolved.(type) {
+		case map[string]any, []any:
+			return e
+		case string:
+			return r
+		default:
+			return fmt.Sprint(r)
+		}
+	case map[string]any:
+		r, ok := resolved.(map[string]any)
+		if !ok {
+			return e
+		}
+		for k, v := range e {
+			e[k] = unredact(v, r[k])
+		}
+	case []any:
+		r, ok := resolved.([]any)
+		if !ok || len(r) != len(e) {
+			return e
+		}
+		for i, v := range e {
+			e[i] = unredact(v, r[i])
+		}
+	}
+	return effective
+}
+
+// durationsToStrings formats the durations like in the YAML configuration, since they are encoded as numbers in JSON.
+func durationsToStrings(v any) any {
+	switch val := v.(type) {
+	case time.Duration:
+		return val.String()
+	case map[string]any:
+		for k, e := range val {
+			val[k] = durationsToStrings(e)
+		}
+	case []any:
+		for i, e := range val {
+			val[i] = durationsToStrings(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+type printHeader struct {
+	Name  string              `mapstructure:"name"`
+	Value configopaque.String `mapstructure:"value"`
+}
+
+type printExporterConfig struct {
+	Token    configopaque.String `mapstructure:"token"`
+	Password configopaque.String `mapstructure:"password"`
+	Headers  []printHeader       `mapstructure:"headers"`
+	Timeout  time.Duration       `mapstructure:"timeout"`
+}
+
+func printFactories() (Factories, error) {
+	factories, err := nopFactories()
+	if err != nil {
+		return Factories{}, err
+	}
+	secretFactory := exporter.NewFactory(component.MustNewType("secret"), func() component.Config {
+		return &printExporterConfig{Timeout: 5 * time.Second}
+	})
+	factories.Exporters[secretFactory.Type()] = secretFactory
+	return factories, nil
+}
+
+func executePrintConfig(t *testing.T, args ...string) (map[string]any, error) {
+	cmd := newPrintConfigSubCommand(CollectorSettings{
+		Factories:              printFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-print.yaml")}),
+	}, flags(featuregate.NewRegistry()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		return nil, err
+	}
+
+	var printed map[string]any
+	if err := yaml.Unmarshal(out.Bytes(), &printed); err != nil {
+		return nil, err
+	}
+	return printed, nil
+}
+
+func TestPrintConfigSubCommand(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		args             []string
+		expectedExporter map[string]any
+	}{
+		{
+			name: "redacted",
+			expectedExporter: map[string]any{
+				"token":    "[REDACTED]",
+				"password": "[REDACTED]",
+				"headers":  []any{map[string]any{"name": "api-key", "value": "[REDACTED]"}},
+				"timeout":  "5s",
+			},
+		},
+		{
+			name: "unredacted",
+			args: []string{"--mode=unredacted"},
+			expectedExporter: map[string]any{
+				// The value of the OS environment variable set by the test provider.
+				"token": "ubuntu",
+				// The values that are not set in the config stay redacted.
+				"password": "[REDACTED]",
+				"headers":  []any{map[string]any{"name": "api-key", "value": "1234"}},
+				"timeout":  "5s",
+			},
+		},
+		{
+			name: "json",
+			args: []string{"--format=json"},
+			expectedExporter: map[string]any{
+				"token":    "[REDACTED]",
+				"password": "[REDACTED]",
+				"headers":  []any{map[string]any{"name": "api-key", "value": "[REDACTED]"}},
+				"timeout":  "5s",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			printed, err := executePrintConfig(t, tt.args...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedExporter, printed["exporters"].(map[string]any)["secret"])
+			assert.Equal(t, map[string]any{"nop": map[string]any{}}, printed["receivers"])
+			assert.Equal(t, []any{"nop"}, printed["service"].(map[string]any)["pipelines"].(map[string]any)["traces"].(map[string]any)["receivers"])
+		})
+	}
+}
+
+func TestPrintConfigSubCommandJSON(t *testing.T) {
+	cmd := newPrintConfigSubCommand(CollectorSettings{
+		Factories:              printFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-print.yaml")}),
+	}, flags(featuregate.NewRegistry()))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--format=json"})
+	require.NoError(t, cmd.Execute())
+	assert.True(t, json.Valid(out.Bytes()))
+}
+
+func TestPrintConfigSubCommandErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "invalid mode",
+			args:        []string{"--mode=plain"},
+			expectedErr: `invalid mode "plain", must be "redacted" or "unredacted"`,
+		},
+		{
+			name:        "invalid format",
+			args:        []string{"--format=toml"},
+			expectedErr: `invalid format "toml", must be "yaml" or "json"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executePrintConfig(t, tt.args...)
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestPrintConfigSubCommandNoConfig(t *testing.T) {
+	cmd := newPrintConfigSubCommand(CollectorSettings{Factories: nopFactories}, flags(featuregate.NewRegistry()))
+	cmd.SetArgs(nil)
+	assert.ErrorContains(t, cmd.Execute(), "at least one config flag must be provided")
+}
+
+func TestUnredact(t *testing.T) {
+	effective := map[string]any{
+		"plain":   "value",
+		"secret":  "[REDACTED]",
+		"number":  "[REDACTED]",
+		"default": "[REDACTED]",
+		"map":     "[REDACTED]",
+		"list":    []any{"[REDACTED]", "[REDACTED]"},
+		"short":   []any{"[REDACTED]"},
+	}
+	resolved := map[string]any{
+		"plain":  "other",
+		"secret": "s3cr3t",
+		"number": 1234,
+		"map":    map[string]any{"key": "value"},
+		"list":   []any{"a", "b"},
+		"short":  []any{"a", "b"},
+	}
+	assert.Equal(t, map[string]any{
+		"plain":   "value",
+		"secret":  "s3cr3t",
+		"number":  "1234",
+		"default": "[REDACTED]",
+		"map":     "[REDACTED]",
+		"list":    []any{"a", "b"},
+		"short":   []any{"[REDACTED]"},
+	}, unredact(effective, resolved))
+}
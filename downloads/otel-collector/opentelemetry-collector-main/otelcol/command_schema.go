@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/otelcol/internal/jsonschema"
+	"go.opentelemetry.io/collector/service"
+	"go.opentelemetry.io/collector/service/telemetry"
+)
+
+// newSchemaCommand constructs a new schema command using the given CollectorSettings.
+func newSchemaCommand(set CollectorSettings) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Outputs the JSON schema of the config of this collector distribution",
+		Long: "Outputs the JSON schema of the config of this collector distribution, including the config of all its " +
+			"components with their default values, to validate the config files in editors and CI. " +
+			"The output format is not stable and can change between releases.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			factories, err := set.Factories()
+			if err != nil {
+				return fmt.Errorf("failed to initialize factories: %w", err)
+			}
+			data, err := json.MarshalIndent(configSchema(set.BuildInfo, factories, set.ConfigDescriptions), "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+			return nil
+		},
+	}
+}
+
+// configSchema returns the JSON schema of the config of a collector built with the given factories.
+// The descriptions are the doc comments of the config structs, see CollectorSettings.ConfigDescriptions.
+func configSchema(info component.BuildInfo, factories Factories, descriptions map[string]string) *jsonschema.Schema {
+	defs := make(map[string]*jsonschema.Schema)
+	title := "OpenTelemetry Collector configuration"
+	if info.Description != "" {
+		title = info.Description + " configuration"
+	}
+
+	telFactory := telemetry.NewFactory()
+	serviceSchema := jsonschema.For(&service.Config{Telemetry: *telFactory.CreateDefaultConfig().(*telemetry.Config)}, descriptions)
+	serviceSchema.Description = "The components enabled in the pipelines and the extensions, and the telemetry of the collector."
+
+	return &jsonschema.Schema{
+		Schema: jsonschema.Draft,
+		Title:  title,
+		Type:   "object",
+		Properties: map[string]*jsonschema.Schema{
+			"receivers":  componentsSchema(component.KindReceiver, factories.Receivers, factories.ReceiverModules, descriptions, defs),
+			"processors": componentsSchema(component.KindProcessor, factories.Processors, factories.ProcessorModules, descriptions, defs),
+			"exporters":  componentsSchema(component.KindExporter, factories.Exporters, factories.ExporterModules, descriptions, defs),
+			"connectors": componentsSchema(component.KindConnector, factories.Connectors, factories.ConnectorModules, descriptions, defs),
+			"extensions": componentsSchema(component.KindExtension, factories.Extensions, factories.ExtensionModules, descriptions, defs),
+			"service":    serviceSchema,
+			"templates": {
+				Description: "The named values inserted with ${template:<name>}, removed from the resolved configuration.",
+				Type:        []string{"object", "null"},
+			},
+		},
+		AdditionalProperties: false,
+		Defs:                 defs,
+	}
+}
+
+// componentsSchema returns the schema of a section of the config, like "receivers", holding the configs of the
+// components by ID. The schema of the config of each component type is added to the defs.
+func componentsSchema[F component.Factory](kind component.Kind, factories map[component.Type]F, modules map[component.Type]string, descriptions map[string]string, defs map[string]*jsonschema.Schema) *jsonschema.Schema {
+	kindName := strings.ToLower(kind.String())
+	s := &jsonschema.Schema{
+		Description:          fmt.Sprintf("The configs of the %ss, by component ID.", kindName),
+		Type:                 []string{"object", "null"},
+		PatternProperties:    make(map[string]*jsonschema.Schema, len(factories)),
+		AdditionalProperties: false,
+	}
+	for _, factory := range sortFactoriesByType[F](factories) {
+		name := kindName + "." + factory.Type().String()
+		cs := jsonschema.For(factory.CreateDefaultConfig(), descriptions)
+		cs.Title = fmt.Sprintf("%s %s", factory.Type(), kindName)
+		if module := modules[factory.Type()]; module != "" {
+			cs.Description = strings.TrimSpace(cs.Description + " Provided by " + module + ".")
+		}
+		defs[name] = cs
+		s.PatternProperties[jsonschema.IDPattern(factory.Type().String())] = &jsonschema.Schema{Ref: "#/$defs/" + name}
+	}
+	return s
+}
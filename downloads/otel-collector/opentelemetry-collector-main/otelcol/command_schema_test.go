@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func TestSchemaCommand(t *testing.T) {
+	cmd := NewCommand(CollectorSettings{BuildInfo: component.NewDefaultBuildInfo(), Factories: printFactories, ConfigDescriptions: map[string]string{
+		"go.opentelemetry.io/collector/otelcol.printExporterConfig":         "Configures the secret exporter.",
+		"go.opentelemetry.io/collector/otelcol.printExporterConfig.Timeout": "Timeout of the requests.",
+		"go.opentelemetry.io/collector/service.Config.Pipelines":            "The pipelines of the collector.",
+	}})
+	cmd.SetArgs([]string{"schema"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+	assert.Equal(t, false, schema["additionalProperties"])
+
+	properties := schema["properties"].(map[string]any)
+	assert.ElementsMatch(t, []string{"receivers", "processors", "exporters", "connectors", "extensions", "service", "templates"}, mapKeys(properties))
+	assert.Equal(t, map[string]any{"$ref": "#/$defs/exporter.secret"},
+		properties["exporters"].(map[string]any)["patternProperties"].(map[string]any)["^secret(/.+)?$"])
+
+	defs := schema["$defs"].(map[string]any)
+	assert.ElementsMatch(t, []string{
+		"receiver.nop", "receiver.nop_logs", "processor.nop", "exporter.nop", "exporter.secret", "connector.nop", "extension.nop",
+	}, mapKeys(defs))
+
+	secret := defs["exporter.secret"].(map[string]any)
+	assert.Equal(t, "secret exporter", secret["title"])
+	assert.Equal(t, "Configures the secret exporter.", secret["description"])
+	secretProperties := secret["properties"].(map[string]any)
+	assert.Equal(t, "5s", secretProperties["timeout"].(map[string]any)["default"])
+	assert.Equal(t, "Timeout of the requests. A duration, like 500ms, 10s or 1h30m.", secretProperties["timeout"].(map[string]any)["description"])
+	assert.Equal(t, true, secretProperties["token"].(map[string]any)["writeOnly"])
+
+	service := properties["service"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, "The pipelines of the collector.", service["pipelines"].(map[string]any)["description"])
+	assert.Contains(t, service, "telemetry")
+}
+
+func TestSchemaCommandFactoriesError(t *testing.T) {
+	cmd := newSchemaCommand(CollectorSettings{Factories: func() (Factories, error) {
+		return Factories{}, errors.New("broken")
+	}})
+	cmd.SetArgs(nil)
+	assert.EqualError(t, cmd.Execute(), "failed to initialize factories: broken")
+}
+
+func mapKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -72,6 +72,12 @@ func TestAddFlagToSettings(t *testing.T) {
 	err = updateSettingsUsingFlags(&set, flgs)
 	require.NoError(t, err)
 	require.Len(t, set.ConfigProviderSettings.ResolverSettings.URIs, 1)
+	assert.Equal(t, confmap.MergeMode(""), set.ConfigProviderSettings.ResolverSettings.MergeMode)
+
+	flgs = flags(featuregate.NewRegistry())
+	require.NoError(t, flgs.Parse([]string{"--config=otelcol-nop.yaml", "--config-merge-mode=append-unique"}))
+	require.NoError(t, updateSettingsUsingFlags(&set, flgs))
+	assert.Equal(t, confmap.MergeModeAppendUnique, set.ConfigProviderSettings.ResolverSettings.MergeMode)
 }
 
 func TestInvalidCollectorSettings(t *testing.T) {
@@ -4,28 +4,107 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 )
 
+const (
+	validateFormatText = "text"
+	validateFormatJSON = "json"
+)
+
+// validateReport is the output of the validate command in the JSON format.
+type validateReport struct {
+	Valid    bool      `json:"valid"`
+	Findings []finding `json:"findings"`
+}
+
 // newValidateSubCommand constructs a new validate sub command using the given CollectorSettings.
 func newValidateSubCommand(set CollectorSettings, flagSet *flag.FlagSet) *cobra.Command {
+	var opts validateOptions
+	var format string
 	validateCmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validates the config without running the collector",
-		Args:  cobra.ExactArgs(0),
+		Long: "Validates the config without running the collector. The command fails if the config is invalid, and " +
+			"with --strict if any problem is found. With --format=json, the problems are printed as a JSON report.",
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format != validateFormatText && format != validateFormatJSON {
+				return fmt.Errorf("invalid format %q, must be %q or %q", format, validateFormatText, validateFormatJSON)
+			}
 			if err := updateSettingsUsingFlags(&set, flagSet); err != nil {
 				return err
 			}
-			col, err := NewCollector(set)
+			if opts == (validateOptions{}) && format == validateFormatText {
+				col, err := NewCollector(set)
+				if err != nil {
+					return err
+				}
+				return col.DryRun(cmd.Context())
+			}
+
+			findings, err := validateConfig(cmd.Context(), set, opts)
 			if err != nil {
 				return err
 			}
-			return col.DryRun(cmd.Context())
+			return reportFindings(cmd.OutOrStdout(), findings, opts.strict, format)
 		},
 	}
+	validateCmd.Flags().BoolVar(&opts.strict, "strict", false,
+		"Also report the components not used in any pipeline and the connectors not used on both sides, "+
+			"and fail on any problem, including the warnings.")
+	validateCmd.Flags().BoolVar(&opts.checkPorts, "check-ports", false,
+		"Check that the endpoints of the receivers and extensions in use can be listened on, by opening and closing them.")
+	validateCmd.Flags().BoolVar(&opts.buildPipelines, "build-pipelines", false,
+		"Build the pipelines and the extensions, without starting them, to find the problems only detected when "+
+			"creating the components.")
+	validateCmd.Flags().StringVar(&format, "format", validateFormatText, "The output format: \"text\" or \"json\".")
 	validateCmd.Flags().AddGoFlagSet(flagSet)
 	return validateCmd
 }
+
+// reportFindings writes the findings in the given format, and returns an error if the validation failed: if there
+// is an error, or in strict mode any finding.
+func reportFindings(out io.Writer, findings []finding, strict bool, format string) error {
+	failed := 0
+	for _, f := range findings {
+		if strict || f.Severity == severityError {
+			failed++
+		}
+	}
+
+	if format == validateFormatJSON {
+		report := validateReport{Valid: failed == 0, Findings: findings}
+		if report.Findings == nil {
+			report.Findings = []finding{}
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintln(out, string(data)); err != nil {
+			return err
+		}
+	} else {
+		if len(findings) == 1 && findings[0].Code == findingInvalidConfig {
+			// Reported as the error, like without the optional checks.
+			return errors.New(findings[0].Message)
+		}
+		for _, f := range findings {
+			if _, err := fmt.Fprintln(out, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("the configuration validation failed with %d problem(s)", failed)
+	}
+	return nil
+}
@@ -4,14 +4,22 @@
 package otelcol
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"net"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/service"
+	"go.opentelemetry.io/collector/service/pipelines"
 )
 
 func TestValidateSubCommandNoConfig(t *testing.T) {
@@ -37,3 +45,140 @@ func TestValidateSubCommandInvalidComponents(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unknown type: \"nosuchprocessor\"")
 }
+
+func executeValidate(t *testing.T, file string, args ...string) (string, error) {
+	cmd := newValidateSubCommand(CollectorSettings{
+		Factories:              nopFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", file)}),
+	}, flags(featuregate.NewRegistry()))
+	// Like in the root command, the usage is not printed on errors.
+	cmd.SilenceUsage = true
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestValidateSubCommandStrict(t *testing.T) {
+	// The unused components and the unpaired connectors are only reported in strict mode.
+	out, err := executeValidate(t, "otelcol-validate-strict.yaml")
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	out, err = executeValidate(t, "otelcol-validate-strict.yaml", "--strict")
+	assert.EqualError(t, err, "the configuration validation failed with 5 problem(s)")
+	assert.Equal(t, []string{
+		"warning: receivers::nop/unused: not used in any pipeline (unused_component)",
+		"warning: processors::nop/unused: not used in any pipeline (unused_component)",
+		"warning: exporters::nop/unused: not used in any pipeline (unused_component)",
+		"warning: extensions::nop/unused: not enabled in service::extensions (unused_component)",
+		"error: connectors::nop/con: used as an exporter in the pipelines [traces] but not as a receiver in any pipeline (unpaired_connector)",
+	}, strings.Split(strings.TrimSpace(out), "\n"))
+
+	out, err = executeValidate(t, "otelcol-nop.yaml", "--strict")
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestValidateSubCommandJSON(t *testing.T) {
+	out, err := executeValidate(t, "otelcol-validate-strict.yaml", "--strict", "--format=json")
+	require.Error(t, err)
+	var report validateReport
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.False(t, report.Valid)
+	require.Len(t, report.Findings, 5)
+	assert.Equal(t, finding{
+		Severity: severityWarning,
+		Code:     findingUnusedComponent,
+		Path:     "receivers::nop/unused",
+		Message:  "not used in any pipeline",
+	}, report.Findings[0])
+
+	// Without strict mode the warnings don't fail the validation.
+	out, err = executeValidate(t, "otelcol-validate-strict.yaml", "--format=json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"valid": true, "findings": []}`, out)
+
+	out, err = executeValidate(t, "otelcol-invalid.yaml", "--format=json")
+	require.Error(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.False(t, report.Valid)
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, findingInvalidConfig, report.Findings[0].Code)
+}
+
+func TestValidateSubCommandBuildPipelines(t *testing.T) {
+	out, err := executeValidate(t, "otelcol-validate-strict.yaml", "--build-pipelines")
+	assert.EqualError(t, err, "the configuration validation failed with 1 problem(s)")
+	assert.Contains(t, out, "failed to build pipelines")
+	assert.Contains(t, out, "(build_failed)")
+
+	out, err = executeValidate(t, "otelcol-nop.yaml", "--build-pipelines")
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestValidateSubCommandInvalidFormat(t *testing.T) {
+	_, err := executeValidate(t, "otelcol-nop.yaml", "--format=xml")
+	assert.EqualError(t, err, `invalid format "xml", must be "text" or "json"`)
+}
+
+type listenerConfig struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	Transport string `mapstructure:"transport"`
+}
+
+type listenerServerConfig struct {
+	GRPC listenerConfig `mapstructure:"grpc"`
+	HTTP listenerConfig `mapstructure:"http"`
+}
+
+func TestUnavailablePorts(t *testing.T) {
+	inUse, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer inUse.Close()
+
+	free, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	freeAddr := free.Addr().String()
+	require.NoError(t, free.Close())
+
+	recvID := component.MustNewID("listener")
+	otherID := component.MustNewIDWithName("listener", "other")
+	unusedID := component.MustNewIDWithName("listener", "unused")
+	cfg := &Config{
+		Receivers: map[component.ID]component.Config{
+			recvID: &listenerServerConfig{
+				GRPC: listenerConfig{Endpoint: inUse.Addr().String()},
+				HTTP: listenerConfig{Endpoint: freeAddr},
+			},
+			// Uses the same endpoint as the first receiver.
+			otherID:  &listenerConfig{Endpoint: freeAddr},
+			unusedID: &listenerConfig{Endpoint: inUse.Addr().String()},
+		},
+		Extensions: map[component.ID]component.Config{
+			component.MustNewID("socket"): &listenerConfig{Endpoint: "/tmp/socket", Transport: "unix"},
+		},
+		Service: service.Config{
+			Extensions: []component.ID{component.MustNewID("socket")},
+			Pipelines: pipelines.Config{
+				component.MustNewID("traces"): {Receivers: []component.ID{recvID, otherID}},
+			},
+		},
+	}
+
+	findings := unavailablePorts(cfg)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "receivers::listener::grpc::endpoint", findings[0].Path)
+	assert.Equal(t, findingPortUnavailable, findings[0].Code)
+	assert.Equal(t, "receivers::listener/other::endpoint", findings[1].Path)
+}
+
+func TestListenEndpoints(t *testing.T) {
+	assert.Equal(t, []listenEndpoint{
+		{path: "receivers::udp::endpoint", network: "udp", address: "localhost:6831"},
+	}, listenEndpoints("receivers::udp", &listenerConfig{Endpoint: "localhost:6831", Transport: "udp"}))
+	assert.Empty(t, listenEndpoints("receivers::url", &listenerConfig{Endpoint: "http://localhost:4318"}))
+	assert.Empty(t, listenEndpoints("receivers::port", &listenerConfig{Endpoint: "localhost:http"}))
+}
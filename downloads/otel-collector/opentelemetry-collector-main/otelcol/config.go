@@ -43,6 +43,12 @@ type Config struct {
 // invalid cases that we currently don't check for but which we may want to add in
 // the future (e.g. disallowing receiving and exporting on the same endpoint).
 func (cfg *Config) Validate() error {
+	return cfg.validate(func(_ string, err error) error { return err })
+}
+
+// validate validates the config like Validate, and calls annotate with the errors about a key of the configuration,
+// like "receivers::otlp", to add details to them.
+func (cfg *Config) validate(annotate func(key string, err error) error) error {
 	// There must be at least one property set in the configuration	file.
 	if len(cfg.Receivers) == 0 && len(cfg.Exporters) == 0 && len(cfg.Processors) == 0 && len(cfg.Connectors) == 0 && len(cfg.Extensions) == 0 {
 		return errEmptyConfigurationFile
@@ -57,7 +63,7 @@ func (cfg *Config) Validate() error {
 	// Validate the receiver configuration.
 	for recvID, recvCfg := range cfg.Receivers {
 		if err := component.ValidateConfig(recvCfg); err != nil {
-			return fmt.Errorf("receivers::%s: %w", recvID, err)
+			return annotate("receivers::"+recvID.String(), fmt.Errorf("receivers::%s: %w", recvID, err))
 		}
 	}
 
@@ -70,39 +76,39 @@ func (cfg *Config) Validate() error {
 	// Validate the exporter configuration.
 	for expID, expCfg := range cfg.Exporters {
 		if err := component.ValidateConfig(expCfg); err != nil {
-			return fmt.Errorf("exporters::%s: %w", expID, err)
+			return annotate("exporters::"+expID.String(), fmt.Errorf("exporters::%s: %w", expID, err))
 		}
 	}
 
 	// Validate the processor configuration.
 	for procID, procCfg := range cfg.Processors {
 		if err := component.ValidateConfig(procCfg); err != nil {
-			return fmt.Errorf("processors::%s: %w", procID, err)
+			return annotate("processors::"+procID.String(), fmt.Errorf("processors::%s: %w", procID, err))
 		}
 	}
 
 	// Validate the connector configuration.
 	for connID, connCfg := range cfg.Connectors {
 		if err := component.ValidateConfig(connCfg); err != nil {
-			return fmt.Errorf("connectors::%s: %w", connID, err)
+			return annotate("connectors::"+connID.String(), fmt.Errorf("connectors::%s: %w", connID, err))
 		}
 
 		if _, ok := cfg.Exporters[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q exporter and %q connector. "+
+			return annotate("connectors::"+connID.String(), fmt.Errorf("connectors::%s: ambiguous ID: Found both %q exporter and %q connector. "+
 				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+				connID, connID, connID, connID, connID.String()+"/connector"))
 		}
 		if _, ok := cfg.Receivers[connID]; ok {
-			return fmt.Errorf("connectors::%s: ambiguous ID: Found both %q receiver and %q connector. "+
+			return annotate("connectors::"+connID.String(), fmt.Errorf("connectors::%s: ambiguous ID: Found both %q receiver and %q connector. "+
 				"Change one of the components' IDs to eliminate ambiguity (e.g. rename %q connector to %q)",
-				connID, connID, connID, connID, connID.String()+"/connector")
+				connID, connID, connID, connID, connID.String()+"/connector"))
 		}
 	}
 
 	// Validate the extension configuration.
 	for extID, extCfg := range cfg.Extensions {
 		if err := component.ValidateConfig(extCfg); err != nil {
-			return fmt.Errorf("extensions::%s: %w", extID, err)
+			return annotate("extensions::"+extID.String(), fmt.Errorf("extensions::%s: %w", extID, err))
 		}
 	}
 
@@ -114,7 +120,7 @@ func (cfg *Config) Validate() error {
 	for _, ref := range cfg.Service.Extensions {
 		// Check that the name referenced in the Service extensions exists in the top-level extensions.
 		if cfg.Extensions[ref] == nil {
-			return fmt.Errorf("service::extensions: references extension %q which is not configured", ref)
+			return annotate("service::extensions", fmt.Errorf("service::extensions: references extension %q which is not configured", ref))
 		}
 	}
 
@@ -130,14 +136,16 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references receiver %q which is not configured", pipelineID, ref)
+			return annotate("service::pipelines::"+pipelineID.String()+"::receivers",
+				fmt.Errorf("service::pipelines::%s: references receiver %q which is not configured", pipelineID, ref))
 		}
 
 		// Validate pipeline processor name references.
 		for _, ref := range pipeline.Processors {
 			// Check that the name referenced in the pipeline's processors exists in the top-level processors.
 			if cfg.Processors[ref] == nil {
-				return fmt.Errorf("service::pipelines::%s: references processor %q which is not configured", pipelineID, ref)
+				return annotate("service::pipelines::"+pipelineID.String()+"::processors",
+					fmt.Errorf("service::pipelines::%s: references processor %q which is not configured", pipelineID, ref))
 			}
 		}
 
@@ -150,7 +158,8 @@ func (cfg *Config) Validate() error {
 			if _, ok := cfg.Connectors[ref]; ok {
 				continue
 			}
-			return fmt.Errorf("service::pipelines::%s: references exporter %q which is not configured", pipelineID, ref)
+			return annotate("service::pipelines::"+pipelineID.String()+"::exporters",
+				fmt.Errorf("service::pipelines::%s: references exporter %q which is not configured", pipelineID, ref))
 		}
 	}
 	return nil
@@ -46,6 +46,9 @@ type ConfigProvider interface {
 
 type configProvider struct {
 	mapResolver *confmap.Resolver
+
+	// conf is the configuration resolved by the last call to Get, to report where the invalid settings were set.
+	conf *confmap.Conf
 }
 
 var _ ConfigProvider = (*configProvider)(nil)
@@ -78,6 +81,7 @@ func (cm *configProvider) Get(ctx context.Context, factories Factories) (*Config
 	if err != nil {
 		return nil, fmt.Errorf("cannot resolve the configuration: %w", err)
 	}
+	cm.conf = conf
 
 	var cfg *configSettings
 	if cfg, err = unmarshal(conf, factories); err != nil {
@@ -8,11 +8,13 @@ import (
 	"flag"
 	"strings"
 
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/featuregate"
 )
 
 const (
-	configFlag = "config"
+	configFlag    = "config"
+	mergeModeFlag = "config-merge-mode"
 )
 
 type configFlagValue struct {
@@ -29,6 +31,18 @@ func (s *configFlagValue) String() string {
 	return "[" + strings.Join(s.values, ", ") + "]"
 }
 
+type mergeModeValue struct {
+	mode confmap.MergeMode
+}
+
+func (m *mergeModeValue) Set(val string) error {
+	return m.mode.UnmarshalText([]byte(val))
+}
+
+func (m *mergeModeValue) String() string {
+	return string(m.mode)
+}
+
 func flags(reg *featuregate.Registry) *flag.FlagSet {
 	flagSet := new(flag.FlagSet)
 
@@ -38,7 +52,7 @@ func flags(reg *featuregate.Registry) *flag.FlagSet {
 
 	flagSet.Func("set",
 		"Set arbitrary component config property. The component has to be defined in the config file and the flag"+
-			" has a higher precedence. Array config properties are overridden and maps are joined. Example --set=processors.batch.timeout=2s",
+			" has a higher precedence. Array config properties are overridden, unless --config-merge-mode is set, and maps are joined. Example --set=processors.batch.timeout=2s",
 		func(s string) error {
 			idx := strings.Index(s, "=")
 			if idx == -1 {
@@ -49,6 +63,10 @@ func flags(reg *featuregate.Registry) *flag.FlagSet {
 			return nil
 		})
 
+	flagSet.Var(new(mergeModeValue), mergeModeFlag, "How the lists are merged when several --config and --set are given:"+
+		" \"replace\" (default) replaces the lists, \"append\" appends the elements of the later lists and \"append-unique\""+
+		" only appends the elements, like component IDs, that are not already present. Maps are always joined.")
+
 	reg.RegisterFlags(flagSet)
 	return flagSet
 }
@@ -57,3 +75,7 @@ func getConfigFlag(flagSet *flag.FlagSet) []string {
 	cfv := flagSet.Lookup(configFlag).Value.(*configFlagValue)
 	return append(cfv.values, cfv.sets...)
 }
+
+func getMergeModeFlag(flagSet *flag.FlagSet) confmap.MergeMode {
+	return flagSet.Lookup(mergeModeFlag).Value.(*mergeModeValue).mode
+}
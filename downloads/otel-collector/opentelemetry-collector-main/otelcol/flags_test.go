@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/featuregate"
 )
 
@@ -69,3 +70,46 @@ func TestSetFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeModeFlag(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedMode confmap.MergeMode
+		expectedErr  string
+	}{
+		{
+			name:         "not set",
+			args:         []string{"--config=file:testdata/otelcol-nop.yaml"},
+			expectedMode: "",
+		},
+		{
+			name:         "append",
+			args:         []string{"--config-merge-mode=append"},
+			expectedMode: confmap.MergeModeAppend,
+		},
+		{
+			name:         "append unique",
+			args:         []string{"--config-merge-mode=append-unique"},
+			expectedMode: confmap.MergeModeAppendUnique,
+		},
+		{
+			name:        "invalid",
+			args:        []string{"--config-merge-mode=prepend"},
+			expectedErr: `invalid value "prepend" for flag -config-merge-mode: unknown merge mode "prepend", must be one of "replace", "append" or "append-unique"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flgs := flags(featuregate.NewRegistry())
+			err := flgs.Parse(tt.args)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMode, getMergeModeFlag(flgs))
+		})
+	}
+}
@@ -15,11 +15,27 @@ import (
 type Configs[F component.Factory] struct {
 	cfgs map[component.ID]component.Config
 
+	kind      component.Kind
 	factories map[component.Type]F
 }
 
-func NewConfigs[F component.Factory](factories map[component.Type]F) *Configs[F] {
-	return &Configs[F]{factories: factories}
+func NewConfigs[F component.Factory](kind component.Kind, factories map[component.Type]F) *Configs[F] {
+	return &Configs[F]{kind: kind, factories: factories}
+}
+
+// ComponentError is the error reading the configuration of a component.
+type ComponentError struct {
+	Kind component.Kind
+	ID   component.ID
+	err  error
+}
+
+func (e *ComponentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ComponentError) Unwrap() error {
+	return e.err
 }
 
 func (c *Configs[F]) Unmarshal(conf *confmap.Conf) error {
@@ -35,13 +51,13 @@ func (c *Configs[F]) Unmarshal(conf *confmap.Conf) error {
 		// Find factory based on component kind and type that we read from config source.
 		factory, ok := c.factories[id.Type()]
 		if !ok {
-			return errorUnknownType(id, maps.Keys(c.factories))
+			return &ComponentError{Kind: c.kind, ID: id, err: errorUnknownType(id, maps.Keys(c.factories))}
 		}
 
 		// Get the configuration from the confmap.Conf to preserve internal representation.
 		sub, err := conf.Sub(id.String())
 		if err != nil {
-			return errorUnmarshalError(id, err)
+			return &ComponentError{Kind: c.kind, ID: id, err: errorUnmarshalError(id, err)}
 		}
 
 		// Create the default config for this component.
@@ -50,7 +66,7 @@ func (c *Configs[F]) Unmarshal(conf *confmap.Conf) error {
 		// Now that the default config struct is created we can Unmarshal into it,
 		// and it will apply user-defined config on top of the default.
 		if err := sub.Unmarshal(&cfg); err != nil {
-			return errorUnmarshalError(id, err)
+			return &ComponentError{Kind: c.kind, ID: id, err: errorUnmarshalError(id, err)}
 		}
 
 		c.cfgs[id] = cfg
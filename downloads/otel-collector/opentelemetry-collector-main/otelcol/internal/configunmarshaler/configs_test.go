@@ -21,35 +21,41 @@ import (
 var nopType = component.MustNewType("nop")
 
 var testKinds = []struct {
-	kind      string
-	factories map[component.Type]component.Factory
+	kind          string
+	componentKind component.Kind
+	factories     map[component.Type]component.Factory
 }{
 	{
-		kind: "receiver",
+		kind:          "receiver",
+		componentKind: component.KindReceiver,
 		factories: map[component.Type]component.Factory{
 			nopType: receivertest.NewNopFactory(),
 		},
 	},
 	{
-		kind: "processor",
+		kind:          "processor",
+		componentKind: component.KindProcessor,
 		factories: map[component.Type]component.Factory{
 			nopType: processortest.NewNopFactory(),
 		},
 	},
 	{
-		kind: "exporter",
+		kind:          "exporter",
+		componentKind: component.KindExporter,
 		factories: map[component.Type]component.Factory{
 			nopType: exportertest.NewNopFactory(),
 		},
 	},
 	{
-		kind: "connector",
+		kind:          "connector",
+		componentKind: component.KindConnector,
 		factories: map[component.Type]component.Factory{
 			nopType: connectortest.NewNopFactory(),
 		},
 	},
 	{
-		kind: "extension",
+		kind:          "extension",
+		componentKind: component.KindExtension,
 		factories: map[component.Type]component.Factory{
 			nopType: extensiontest.NewNopFactory(),
 		},
@@ -59,7 +65,7 @@ var testKinds = []struct {
 func TestUnmarshal(t *testing.T) {
 	for _, tk := range testKinds {
 		t.Run(tk.kind, func(t *testing.T) {
-			cfgs := NewConfigs(tk.factories)
+			cfgs := NewConfigs(tk.componentKind, tk.factories)
 			conf := confmap.NewFromStringMap(map[string]any{
 				"nop":              nil,
 				"nop/my" + tk.kind: nil,
@@ -134,7 +140,7 @@ func TestUnmarshalError(t *testing.T) {
 
 			for _, tt := range testCases {
 				t.Run(tt.name, func(t *testing.T) {
-					cfgs := NewConfigs(tk.factories)
+					cfgs := NewConfigs(tk.componentKind, tk.factories)
 					err := cfgs.Unmarshal(tt.conf)
 					require.Error(t, err)
 					assert.Contains(t, err.Error(), tt.expectedError)
@@ -143,3 +149,19 @@ func TestUnmarshalError(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalComponentError(t *testing.T) {
+	for _, tk := range testKinds {
+		t.Run(tk.kind, func(t *testing.T) {
+			cfgs := NewConfigs(tk.componentKind, tk.factories)
+			err := cfgs.Unmarshal(confmap.NewFromStringMap(map[string]any{
+				"nop/my" + tk.kind: map[string]any{"unknown_section": tk.kind},
+			}))
+			var componentErr *ComponentError
+			require.ErrorAs(t, err, &componentErr)
+			assert.Equal(t, tk.componentKind, componentErr.Kind)
+			assert.Equal(t, component.NewIDWithName(nopType, "my"+tk.kind), componentErr.ID)
+			assert.EqualError(t, componentErr, err.Error())
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonschema generates the JSON schemas of the configuration structs, following the rules
+// used by confmap to unmarshal them: the keys are the `mapstructure` tags, the `squash` structs are
+// inlined, and the unknown keys are rejected unless the struct unmarshals itself. The structs and their
+// fields are described with their doc comments, which are extracted from the sources at build time.
+package jsonschema // import "go.opentelemetry.io/collector/otelcol/internal/jsonschema"
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// Draft is the JSON Schema version of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// IDPattern returns the pattern matching the IDs of the components of the given type.
+func IDPattern(typ string) string {
+	return "^" + typ + "(/.+)?$"
+}
+
+// nullableObject is the type of the maps and structs: they can be left empty in the configuration, like `otlp:`,
+// to keep their default values.
+var nullableObject = []string{"object", "null"}
+
+// idPattern matches the IDs of any component.
+const idPattern = "^[a-zA-Z][0-9a-zA-Z_]{0,62}(/.+)?$"
+
+// Schema is a JSON schema.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is the name of a type, or a list of type names.
+	Type    any    `json:"type,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+	Default any    `json:"default,omitempty"`
+	// WriteOnly marks the sensitive values, which are never shown back.
+	WriteOnly            bool               `json:"writeOnly,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	PatternProperties    map[string]*Schema `json:"patternProperties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	opaqueType          = reflect.TypeOf(configopaque.String(""))
+	idType              = reflect.TypeOf(component.ID{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	unmarshalerType     = reflect.TypeOf((*confmap.Unmarshaler)(nil)).Elem()
+)
+
+// For returns the schema of the given value, with its non-zero fields as defaults.
+// The value is usually the default configuration of a component.
+// The descriptions are the doc comments of the structs and of their fields, keyed by their Go path,
+// like "go.opentelemetry.io/collector/exporter/otlpexporter.Config.Timeout". They can be nil.
+func For(v any, descriptions map[string]string) *Schema {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return &Schema{}
+	}
+	g := &generator{visiting: make(map[reflect.Type]bool), descriptions: descriptions}
+	return g.schema(val.Type(), val)
+}
+
+type generator struct {
+	// visiting holds the structs being generated, to stop on the recursive types.
+	visiting map[reflect.Type]bool
+	// descriptions holds the doc comments of the structs and fields, see For.
+	descriptions map[string]string
+}
+
+// description returns the description of the struct t, or of its field if a field name is given.
+func (g *generator) description(t reflect.Type, field string) string {
+	if t.PkgPath() == "" || t.Name() == "" {
+		return ""
+	}
+	key := t.PkgPath() + "." + t.Name()
+	if field != "" {
+		key += "." + field
+	}
+	return g.descriptions[key]
+}
+
+// schema returns the schema of the type t. The value def holds the default, it is invalid if there is none.
+func (g *generator) schema(t reflect.Type, def reflect.Value) *Schema {
+	if t.Kind() == reflect.Pointer {
+		if def.IsValid() && !def.IsNil() {
+			return g.schema(t.Elem(), def.Elem())
+		}
+		return g.schema(t.Elem(), reflect.Value{})
+	}
+
+	switch {
+	case t == durationType:
+		return &Schema{
+			Type:        "string",
+			Description: "A duration, like 500ms, 10s or 1h30m.",
+			Pattern:     `^[-+]?([0-9]*(\.[0-9]*)?(ns|us|µs|μs|ms|s|m|h))+$|^[-+]?0$`,
+			Default:     defaultOf(def),
+		}
+	case t == opaqueType:
+		// The default of a sensitive value is never shown.
+		return &Schema{Type: "string", Description: "A sensitive value, redacted in the logs.", WriteOnly: true}
+	case t == idType:
+		return &Schema{Type: "string", Description: "A component ID, like otlp or otlp/2.", Pattern: idPattern, Default: defaultOf(def)}
+	case t.Kind() != reflect.String && reflect.PointerTo(t).Implements(textUnmarshalerType):
+		// The types unmarshaled from a text, like the levels.
+		return &Schema{Type: "string", Default: defaultOf(def)}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean", Default: defaultOf(def)}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Default: defaultOf(def)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Default: defaultOf(def)}
+	case reflect.String:
+		return &Schema{Type: "string", Default: defaultOf(def)}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string"}
+		}
+		return &Schema{Type: "array", Items: g.schema(t.Elem(), reflect.Value{}), Default: defaultOf(def)}
+	case reflect.Map:
+		s := &Schema{Type: nullableObject, AdditionalProperties: g.schema(t.Elem(), reflect.Value{})}
+		if t.Key() == idType {
+			s.PropertyNames = &Schema{Pattern: idPattern}
+		}
+		return s
+	case reflect.Struct:
+		if g.visiting[t] {
+			return &Schema{}
+		}
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+
+		s := &Schema{Type: nullableObject, Description: g.description(t, ""), Properties: make(map[string]*Schema)}
+		// The structs unmarshaling themselves can accept other keys than their fields.
+		if !reflect.PointerTo(t).Implements(unmarshalerType) {
+			s.AdditionalProperties = false
+		}
+		g.addFields(s, t, def)
+		return s
+	}
+	// The interfaces can hold any value, and the other kinds can't be unmarshaled.
+	return &Schema{}
+}
+
+// addFields adds the fields of the struct t to the properties of s, inlining the squashed structs.
+func (g *generator) addFields(s *Schema, t reflect.Type, def reflect.Value) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		var fieldDef reflect.Value
+		if def.IsValid() {
+			fieldDef = def.Field(i)
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		switch {
+		case name == "-":
+			continue
+		case hasOption(opts, "remain"):
+			s.AdditionalProperties = true
+			continue
+		case hasOption(opts, "squash"):
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+				if fieldDef.IsValid() && !fieldDef.IsNil() {
+					fieldDef = fieldDef.Elem()
+				} else {
+					fieldDef = reflect.Value{}
+				}
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(s, ft, fieldDef)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fs := g.schema(field.Type, fieldDef)
+		if desc := g.description(t, field.Name); desc != "" {
+			// Keep the description of the values with a known format, like the durations.
+			if fs.Properties == nil && fs.Description != "" {
+				desc += " " + fs.Description
+			}
+			fs.Description = desc
+		}
+		s.Properties[name] = fs
+	}
+}
+
+func hasOption(opts, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultOf returns the default value as it is written in the configuration, or nil if it is unset or zero.
+func defaultOf(v reflect.Value) any {
+	if !v.IsValid() || v.IsZero() {
+		return nil
+	}
+	switch {
+	case v.Type() == opaqueType:
+		return nil
+	case v.Type() == durationType:
+		return time.Duration(v.Int()).String()
+	case v.Type().Implements(textMarshalerType):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil
+		}
+		return string(text)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		values := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem := defaultOf(v.Index(i))
+			if elem == nil {
+				// Only the lists of non-zero values are shown as defaults.
+				return nil
+			}
+			values = append(values, elem)
+		}
+		return values
+	}
+	return nil
+}
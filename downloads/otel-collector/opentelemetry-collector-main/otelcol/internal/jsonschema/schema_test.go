@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+type Embedded struct {
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+type nested struct {
+	Enabled bool    `mapstructure:"enabled"`
+	Next    *nested `mapstructure:"next"`
+}
+
+type testConfig struct {
+	Embedded   `mapstructure:",squash"`
+	Name       string                  `mapstructure:"name"`
+	Count      int                     `mapstructure:"count"`
+	Ratio      float64                 `mapstructure:"ratio"`
+	Timeout    time.Duration           `mapstructure:"timeout"`
+	Token      configopaque.String     `mapstructure:"token"`
+	Level      zapcore.Level           `mapstructure:"level"`
+	Exporter   component.ID            `mapstructure:"exporter"`
+	Tags       []string                `mapstructure:"tags"`
+	Attributes map[string]string       `mapstructure:"attributes"`
+	Routes     map[component.ID]string `mapstructure:"routes"`
+	Nested     nested                  `mapstructure:"nested"`
+	Data       []byte                  `mapstructure:"data"`
+	Any        any                     `mapstructure:"any"`
+	Ignored    string                  `mapstructure:"-"`
+	Untagged   string
+	unexported string
+}
+
+func TestFor(t *testing.T) {
+	s := For(&testConfig{
+		Embedded:   Embedded{Endpoint: "localhost:4317"},
+		Count:      10,
+		Timeout:    5 * time.Second,
+		Token:      "secret",
+		Level:      zapcore.WarnLevel,
+		Exporter:   component.MustNewIDWithName("otlp", "2"),
+		Tags:       []string{"a", "b"},
+		unexported: "ignored",
+	}, nil)
+
+	assert.Equal(t, nullableObject, s.Type)
+	assert.Equal(t, false, s.AdditionalProperties)
+	assert.ElementsMatch(t, []string{
+		"endpoint", "name", "count", "ratio", "timeout", "token", "level", "exporter",
+		"tags", "attributes", "routes", "nested", "data", "any", "Untagged",
+	}, keys(s.Properties))
+
+	assert.Equal(t, &Schema{Type: "string", Default: "localhost:4317"}, s.Properties["endpoint"])
+	assert.Equal(t, &Schema{Type: "string"}, s.Properties["name"])
+	assert.Equal(t, &Schema{Type: "integer", Default: int64(10)}, s.Properties["count"])
+	assert.Equal(t, &Schema{Type: "number"}, s.Properties["ratio"])
+	assert.Equal(t, "string", s.Properties["timeout"].Type)
+	assert.Equal(t, "5s", s.Properties["timeout"].Default)
+	assert.True(t, s.Properties["token"].WriteOnly)
+	assert.Nil(t, s.Properties["token"].Default)
+	assert.Equal(t, &Schema{Type: "string", Default: "warn"}, s.Properties["level"])
+	assert.Equal(t, "otlp/2", s.Properties["exporter"].Default)
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}, Default: []any{"a", "b"}}, s.Properties["tags"])
+	assert.Equal(t, &Schema{Type: nullableObject, AdditionalProperties: &Schema{Type: "string"}}, s.Properties["attributes"])
+	assert.Equal(t, &Schema{Pattern: idPattern}, s.Properties["routes"].PropertyNames)
+	assert.Equal(t, &Schema{Type: "string"}, s.Properties["data"])
+	assert.Equal(t, &Schema{}, s.Properties["any"])
+
+	// The recursive types stop at the first repetition.
+	assert.Equal(t, &Schema{}, s.Properties["nested"].Properties["next"])
+	assert.Equal(t, &Schema{Type: "boolean"}, s.Properties["nested"].Properties["enabled"])
+}
+
+func TestForRemain(t *testing.T) {
+	s := For(struct {
+		Name  string         `mapstructure:"name"`
+		Other map[string]any `mapstructure:",remain"`
+	}{}, nil)
+	assert.Equal(t, true, s.AdditionalProperties)
+	assert.Equal(t, []string{"name"}, keys(s.Properties))
+}
+
+func TestForNil(t *testing.T) {
+	assert.Equal(t, &Schema{}, For(nil, nil))
+
+	// The nil pointers have no defaults.
+	var cfg *testConfig
+	s := For(cfg, nil)
+	assert.Equal(t, nullableObject, s.Type)
+	assert.Nil(t, s.Properties["timeout"].Default)
+}
+
+// selfUnmarshaled unmarshals itself, so it can accept other keys than its fields.
+type selfUnmarshaled struct {
+	Name string `mapstructure:"name"`
+}
+
+func (*selfUnmarshaled) Unmarshal(*confmap.Conf) error {
+	return nil
+}
+
+func TestForUnmarshaler(t *testing.T) {
+	s := For(struct {
+		Self selfUnmarshaled `mapstructure:"self"`
+	}{}, nil)
+	assert.Equal(t, false, s.AdditionalProperties)
+	assert.Nil(t, s.Properties["self"].AdditionalProperties)
+	assert.Equal(t, []string{"name"}, keys(s.Properties["self"].Properties))
+}
+
+func TestForDescriptions(t *testing.T) {
+	const pkg = "go.opentelemetry.io/collector/otelcol/internal/jsonschema."
+	s := For(&testConfig{}, map[string]string{
+		pkg + "testConfig":         "Configures the test component.",
+		pkg + "testConfig.Name":    "Name of the test.",
+		pkg + "testConfig.Timeout": "How long to wait.",
+		pkg + "testConfig.Nested":  "The nested settings.",
+		pkg + "nested":             "Ignored for the fields described by the struct holding them.",
+		pkg + "nested.Enabled":     "Enables the nested settings.",
+		pkg + "Embedded.Endpoint":  "Where to send the data.",
+	})
+
+	assert.Equal(t, "Configures the test component.", s.Description)
+	assert.Equal(t, "Name of the test.", s.Properties["name"].Description)
+	assert.Equal(t, "Where to send the data.", s.Properties["endpoint"].Description)
+	// The format of the durations is still described.
+	assert.Equal(t, "How long to wait. A duration, like 500ms, 10s or 1h30m.", s.Properties["timeout"].Description)
+	assert.Equal(t, "The nested settings.", s.Properties["nested"].Description)
+	assert.Equal(t, "Enables the nested settings.", s.Properties["nested"].Properties["enabled"].Description)
+	assert.Empty(t, s.Properties["count"].Description)
+}
+
+func TestIDPattern(t *testing.T) {
+	assert.Equal(t, "^otlp(/.+)?$", IDPattern("otlp"))
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(&Schema{
+		Schema:               Draft,
+		Type:                 nullableObject,
+		Properties:           map[string]*Schema{"timeout": {Type: "string", Default: "5s"}},
+		AdditionalProperties: false,
+		Defs:                 map[string]*Schema{"other": {Ref: "#/$defs/other"}},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": ["object", "null"],
+		"properties": {"timeout": {"type": "string", "default": "5s"}},
+		"additionalProperties": false,
+		"$defs": {"other": {"$ref": "#/$defs/other"}}
+	}`, string(data))
+}
+
+func keys(m map[string]*Schema) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// withOrigins annotates the error with where the given key of the configuration was set: the configurations
+// setting the key, and the ${...} references the values under the key were expanded from.
+// The error is returned as is if the origins are unknown, like when the configuration was not resolved.
+func withOrigins(conf *confmap.Conf, key string, err error) error {
+	if conf == nil || err == nil {
+		return err
+	}
+	var set, expanded []string
+	for _, origin := range conf.Origins(key) {
+		if !strings.HasPrefix(origin.Key, key+confmap.KeyDelimiter) {
+			// The key itself, or its closest parent when nothing was recorded for the key.
+			set = append(set, origin.String())
+		} else if len(origin.Expansions) > 0 {
+			expanded = append(expanded, strings.TrimPrefix(origin.Key, key+confmap.KeyDelimiter)+": "+origin.String())
+		}
+	}
+	if len(set) == 0 && len(expanded) == 0 {
+		return err
+	}
+
+	var b strings.Builder
+	if len(set) > 0 {
+		b.WriteString("set in " + strings.Join(set, ", "))
+	}
+	if len(expanded) > 0 {
+		if b.Len() > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(strings.Join(expanded, "; "))
+	}
+	return fmt.Errorf("%w (%s)", err, b.String())
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// newYAMLConfigProviderSettings returns settings reading the files with the "yaml" scheme like the file provider,
+// recording the lines of the keys.
+func newYAMLConfigProviderSettings(t *testing.T, uris []string) ConfigProviderSettings {
+	yamlProvider := newFakeProvider("yaml", func(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+		content, err := os.ReadFile(filepath.Clean(uri[len("yaml:"):]))
+		require.NoError(t, err)
+		return confmap.NewRetrievedFromYAML(content)
+	})
+	return ConfigProviderSettings{
+		ResolverSettings: confmap.ResolverSettings{
+			URIs:              uris,
+			ProviderFactories: []confmap.ProviderFactory{yamlProvider, newEnvProvider()},
+		},
+	}
+}
+
+func TestConfigProviderUnmarshalErrorOrigins(t *testing.T) {
+	provider, err := NewConfigProvider(newYAMLConfigProviderSettings(t, []string{"yaml:" + filepath.Join("testdata", "otelcol-invalid-origins.yaml")}))
+	require.NoError(t, err)
+
+	factories, err := nopFactories()
+	require.NoError(t, err)
+	_, err = provider.Get(context.Background(), factories)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `error reading configuration for "nop"`)
+	assert.Contains(t, err.Error(), "(set in yaml:testdata/otelcol-invalid-origins.yaml:5; "+
+		"endpoint: yaml:testdata/otelcol-invalid-origins.yaml:6 expanded from ${env:HOST})")
+}
+
+func TestCollectorDryRunErrorOrigins(t *testing.T) {
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:              component.NewDefaultBuildInfo(),
+		Factories:              nopFactories,
+		ConfigProviderSettings: newYAMLConfigProviderSettings(t, []string{"yaml:" + filepath.Join("testdata", "otelcol-invalid.yaml")}),
+	})
+	require.NoError(t, err)
+
+	err = col.DryRun(context.Background())
+	require.EqualError(t, err, `service::pipelines::traces: references processor "invalid" which is not configured `+
+		`(set in yaml:testdata/otelcol-invalid.yaml:17)`)
+}
+
+func TestWithOrigins(t *testing.T) {
+	errInvalid := errors.New("invalid")
+	assert.Equal(t, errInvalid, withOrigins(nil, "receivers::nop", errInvalid))
+	assert.Equal(t, errInvalid, withOrigins(confmap.New(), "receivers::nop", errInvalid))
+	assert.NoError(t, withOrigins(confmap.New(), "receivers::nop", nil))
+}
@@ -30,6 +30,8 @@ func LoadConfig(fileName string, factories otelcol.Factories) (*otelcol.Config,
 	if err != nil {
 		return nil, err
 	}
+	// The configuration is loaded once, stop watching the retrieved values.
+	defer func() { _ = provider.Shutdown(context.Background()) }()
 	return provider.Get(context.Background(), factories)
 }
 
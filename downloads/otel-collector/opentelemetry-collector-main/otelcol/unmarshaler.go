@@ -4,6 +4,10 @@
 package otelcol // import "go.opentelemetry.io/collector/otelcol"
 
 import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
@@ -33,16 +37,27 @@ func unmarshal(v *confmap.Conf, factories Factories) (*configSettings, error) {
 
 	// Unmarshal top level sections and validate.
 	cfg := &configSettings{
-		Receivers:  configunmarshaler.NewConfigs(factories.Receivers),
-		Processors: configunmarshaler.NewConfigs(factories.Processors),
-		Exporters:  configunmarshaler.NewConfigs(factories.Exporters),
-		Connectors: configunmarshaler.NewConfigs(factories.Connectors),
-		Extensions: configunmarshaler.NewConfigs(factories.Extensions),
+		Receivers:  configunmarshaler.NewConfigs(component.KindReceiver, factories.Receivers),
+		Processors: configunmarshaler.NewConfigs(component.KindProcessor, factories.Processors),
+		Exporters:  configunmarshaler.NewConfigs(component.KindExporter, factories.Exporters),
+		Connectors: configunmarshaler.NewConfigs(component.KindConnector, factories.Connectors),
+		Extensions: configunmarshaler.NewConfigs(component.KindExtension, factories.Extensions),
 		// TODO: Add a component.ServiceFactory to allow this to be defined by the Service.
 		Service: service.Config{
 			Telemetry: defaultTelConfig,
 		},
 	}
 
-	return cfg, v.Unmarshal(&cfg)
+	err := v.Unmarshal(&cfg)
+	var componentErr *configunmarshaler.ComponentError
+	if errors.As(err, &componentErr) {
+		err = withOrigins(v, sectionKey(componentErr.Kind)+confmap.KeyDelimiter+componentErr.ID.String(), err)
+	}
+	return cfg, err
+}
+
+// sectionKey returns the key of the section of the configuration holding the components of the given kind,
+// like "receivers".
+func sectionKey(kind component.Kind) string {
+	return strings.ToLower(kind.String()) + "s"
 }
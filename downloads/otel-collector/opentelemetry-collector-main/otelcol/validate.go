@@ -0,0 +1,286 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcol // import "go.opentelemetry.io/collector/otelcol"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/service"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+
+	// findingInvalidConfig is reported when the configuration cannot be loaded or is invalid.
+	findingInvalidConfig = "invalid_config"
+	// findingUnusedComponent is reported for the components defined but not used in any pipeline,
+	// and the extensions not enabled in the service.
+	findingUnusedComponent = "unused_component"
+	// findingUnpairedConnector is reported for the connectors used only as an exporter or only as a receiver.
+	findingUnpairedConnector = "unpaired_connector"
+	// findingPortUnavailable is reported for the endpoints the collector would fail to listen on.
+	findingPortUnavailable = "port_unavailable"
+	// findingBuildFailed is reported when the pipelines or the extensions cannot be built.
+	findingBuildFailed = "build_failed"
+)
+
+// finding is a problem found in the configuration by the validate command.
+type finding struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code"`
+	// Path is the path of the setting or component the finding is about, like "receivers::otlp", if any.
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+func (f finding) String() string {
+	if f.Path == "" {
+		return fmt.Sprintf("%s: %s (%s)", f.Severity, f.Message, f.Code)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Severity, f.Path, f.Message, f.Code)
+}
+
+// validateOptions are the optional checks of the validate command.
+type validateOptions struct {
+	// strict reports the unused components and the unpaired connectors.
+	strict bool
+	// checkPorts tries to listen on the endpoints of the receivers and extensions in use.
+	checkPorts bool
+	// buildPipelines builds the pipelines and the extensions, without starting them.
+	buildPipelines bool
+}
+
+// validateConfig loads and validates the configuration of the collector, and runs the optional checks on it.
+// The error is only returned if the checks could not run; the problems of the configuration are returned as findings.
+func validateConfig(ctx context.Context, set CollectorSettings, opts validateOptions) ([]finding, error) {
+	col, err := NewCollector(set)
+	if err != nil {
+		return nil, err
+	}
+	factories, cfg, err := col.dryRun(ctx)
+	if err != nil {
+		return []finding{{Severity: severityError, Code: findingInvalidConfig, Message: err.Error()}}, nil
+	}
+
+	var findings []finding
+	if opts.strict {
+		findings = append(findings, unusedComponents(cfg)...)
+		findings = append(findings, unpairedConnectors(cfg)...)
+	}
+	if opts.checkPorts {
+		findings = append(findings, unavailablePorts(cfg)...)
+	}
+	if opts.buildPipelines {
+		serviceSet, err := col.serviceSettings(factories, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if err = service.Validate(ctx, serviceSet, cfg.Service); err != nil {
+			findings = append(findings, finding{Severity: severityError, Code: findingBuildFailed, Message: err.Error()})
+		}
+	}
+	return findings, nil
+}
+
+// unusedComponents returns the receivers, processors, exporters and connectors not used in any pipeline,
+// and the extensions not enabled in the service.
+func unusedComponents(cfg *Config) []finding {
+	used := make(map[component.ID]bool)
+	for _, pipeline := range cfg.Service.Pipelines {
+		for _, ids := range [][]component.ID{pipeline.Receivers, pipeline.Processors, pipeline.Exporters} {
+			for _, id := range ids {
+				used[id] = true
+			}
+		}
+	}
+	enabled := make(map[component.ID]bool)
+	for _, id := range cfg.Service.Extensions {
+		enabled[id] = true
+	}
+
+	var findings []finding
+	unused := func(section string, ids []component.ID, isUsed map[component.ID]bool, message string) {
+		for _, id := range ids {
+			if !isUsed[id] {
+				findings = append(findings, finding{
+					Severity: severityWarning,
+					Code:     findingUnusedComponent,
+					Path:     section + "::" + id.String(),
+					Message:  message,
+				})
+			}
+		}
+	}
+	unused("receivers", sortedIDs(cfg.Receivers), used, "not used in any pipeline")
+	unused("processors", sortedIDs(cfg.Processors), used, "not used in any pipeline")
+	unused("exporters", sortedIDs(cfg.Exporters), used, "not used in any pipeline")
+	unused("connectors", sortedIDs(cfg.Connectors), used, "not used in any pipeline")
+	unused("extensions", sortedIDs(cfg.Extensions), enabled, "not enabled in service::extensions")
+	return findings
+}
+
+// unpairedConnectors returns the connectors used as an exporter but not as a receiver, or the other way around:
+// a connector must be used on both sides to connect pipelines.
+func unpairedConnectors(cfg *Config) []finding {
+	asExporter := make(map[component.ID][]string)
+	asReceiver := make(map[component.ID][]string)
+	for _, pipelineID := range sortedIDs(cfg.Service.Pipelines) {
+		pipeline := cfg.Service.Pipelines[pipelineID]
+		for _, id := range pipeline.Exporters {
+			if _, ok := cfg.Connectors[id]; ok {
+				asExporter[id] = append(asExporter[id], pipelineID.String())
+			}
+		}
+		for _, id := range pipeline.Receivers {
+			if _, ok := cfg.Connectors[id]; ok {
+				asReceiver[id] = append(asReceiver[id], pipelineID.String())
+			}
+		}
+	}
+
+	var findings []finding
+	for _, id := range sortedIDs(cfg.Connectors) {
+		var message string
+		switch {
+		case len(asExporter[id]) > 0 && len(asReceiver[id]) == 0:
+			message = fmt.Sprintf("used as an exporter in the pipelines [%s] but not as a receiver in any pipeline",
+				strings.Join(asExporter[id], ", "))
+		case len(asReceiver[id]) > 0 && len(asExporter[id]) == 0:
+			message = fmt.Sprintf("used as a receiver in the pipelines [%s] but not as an exporter in any pipeline",
+				strings.Join(asReceiver[id], ", "))
+		default:
+			continue
+		}
+		findings = append(findings, finding{
+			Severity: severityError,
+			Code:     findingUnpairedConnector,
+			Path:     "connectors::" + id.String(),
+			Message:  message,
+		})
+	}
+	return findings
+}
+
+// unavailablePorts tries to listen on the endpoints of the receivers used in the pipelines and of the enabled
+// extensions, and returns the ones that failed. The listeners are kept open until all the endpoints are checked,
+// so that the endpoints used by several components are reported too.
+func unavailablePorts(cfg *Config) []finding {
+	used := make(map[component.ID]bool)
+	for _, pipeline := range cfg.Service.Pipelines {
+		for _, id := range pipeline.Receivers {
+			used[id] = true
+		}
+	}
+
+	var endpoints []listenEndpoint
+	for _, id := range sortedIDs(cfg.Receivers) {
+		if used[id] {
+			endpoints = append(endpoints, listenEndpoints("receivers::"+id.String(), cfg.Receivers[id])...)
+		}
+	}
+	for _, id := range cfg.Service.Extensions {
+		endpoints = append(endpoints, listenEndpoints("extensions::"+id.String(), cfg.Extensions[id])...)
+	}
+
+	var findings []finding
+	var listeners []io.Closer
+	for _, endpoint := range endpoints {
+		listener, err := endpoint.listen()
+		if err != nil {
+			findings = append(findings, finding{
+				Severity: severityError,
+				Code:     findingPortUnavailable,
+				Path:     endpoint.path,
+				Message:  fmt.Sprintf("cannot listen on %q: %v", endpoint.address, err),
+			})
+			continue
+		}
+		listeners = append(listeners, listener)
+	}
+	for _, listener := range listeners {
+		// The listeners were only opened to check the endpoints, a failure to close them doesn't matter.
+		_ = listener.Close()
+	}
+	return findings
+}
+
+// listenEndpoint is an address a component listens on.
+type listenEndpoint struct {
+	path    string
+	network string
+	address string
+}
+
+func (e listenEndpoint) listen() (io.Closer, error) {
+	if strings.HasPrefix(e.network, "udp") {
+		return net.ListenPacket(e.network, e.address)
+	}
+	return net.Listen(e.network, e.address)
+}
+
+// listenEndpoints returns the "endpoint" settings holding a "host:port" address in the given component config,
+// like the ones of the confighttp.ServerConfig and configgrpc.ServerConfig. Their network is given by the sibling
+// "transport" setting, if any, and the unix sockets are skipped.
+func listenEndpoints(path string, cfg component.Config) []listenEndpoint {
+	conf := confmap.New()
+	if err := conf.Marshal(cfg); err != nil {
+		return nil
+	}
+	var endpoints []listenEndpoint
+	var walk func(path string, m map[string]any)
+	walk = func(path string, m map[string]any) {
+		if address, ok := m["endpoint"].(string); ok && isHostPort(address) {
+			network, _ := m["transport"].(string)
+			switch {
+			case network == "":
+				network = "tcp"
+			case strings.HasPrefix(network, "unix"):
+				network = ""
+			}
+			if network != "" {
+				endpoints = append(endpoints, listenEndpoint{path: path + "::endpoint", network: network, address: address})
+			}
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if sub, ok := m[k].(map[string]any); ok {
+				walk(path+"::"+k, sub)
+			}
+		}
+	}
+	walk(path, conf.ToStringMap())
+	return endpoints
+}
+
+// isHostPort returns whether the address is a "host:port" address with a numeric port.
+func isHostPort(address string) bool {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	_, err = strconv.ParseUint(port, 10, 16)
+	return err == nil
+}
+
+func sortedIDs[V any](m map[component.ID]V) []component.ID {
+	ids := make([]component.ID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
@@ -4,6 +4,7 @@
 package plog
 
 import (
+	"math/bits"
 	"testing"
 	"time"
 
@@ -37,6 +38,45 @@ func TestProtoSizerEmptyLogs(t *testing.T) {
 	assert.Equal(t, 0, sizer.LogsSize(NewLogs()))
 }
 
+func TestProtoElementSizers(t *testing.T) {
+	marshaler := &ProtoMarshaler{}
+	ld := NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "test")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetSeverityText("error")
+	lr.Body().SetStr("log message")
+
+	// Every element is encoded in its parent with a one byte tag and its length.
+	lrSize := marshaler.LogRecordSize(lr)
+	slSize := marshaler.ScopeLogsSize(sl)
+	rlSize := marshaler.ResourceLogsSize(rl)
+	assert.Equal(t, slSize, marshaler.ScopeLogsSize(newScopeLogsWithoutRecords(sl))+deltaSize(lrSize))
+	assert.Equal(t, rlSize, marshaler.ResourceLogsSize(newResourceLogsWithoutScopes(rl))+deltaSize(slSize))
+	assert.Equal(t, marshaler.LogsSize(ld), deltaSize(rlSize))
+}
+
+func newScopeLogsWithoutRecords(sl ScopeLogs) ScopeLogs {
+	dest := NewScopeLogs()
+	sl.Scope().CopyTo(dest.Scope())
+	dest.SetSchemaUrl(sl.SchemaUrl())
+	return dest
+}
+
+func newResourceLogsWithoutScopes(rl ResourceLogs) ResourceLogs {
+	dest := NewResourceLogs()
+	rl.Resource().CopyTo(dest.Resource())
+	dest.SetSchemaUrl(rl.SchemaUrl())
+	return dest
+}
+
+// deltaSize returns the size of a message of the given size encoded as a field of its parent.
+func deltaSize(size int) int {
+	return 1 + (bits.Len64(uint64(size)|1)+6)/7 + size
+}
+
 func BenchmarkLogsToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	logs := generateBenchmarkLogs(128)
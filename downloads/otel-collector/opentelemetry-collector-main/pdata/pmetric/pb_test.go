@@ -4,6 +4,7 @@
 package pmetric
 
 import (
+	"math/bits"
 	"testing"
 	"time"
 
@@ -36,6 +37,48 @@ func TestProtoSizerEmptyMetrics(t *testing.T) {
 	assert.Equal(t, 0, sizer.MetricsSize(NewMetrics()))
 }
 
+func TestProtoElementSizers(t *testing.T) {
+	marshaler := &ProtoMarshaler{}
+	md := NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("gauge")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetIntValue(42)
+
+	// Every element is encoded in its parent with a one byte tag and its length.
+	dpSize := marshaler.NumberDataPointSize(dp)
+	mSize := marshaler.MetricSize(m)
+	smSize := marshaler.ScopeMetricsSize(sm)
+	rmSize := marshaler.ResourceMetricsSize(rm)
+	assert.Equal(t, mSize, len("gauge")+2+deltaSize(deltaSize(dpSize)))
+	emptySM := NewScopeMetrics()
+	sm.Scope().CopyTo(emptySM.Scope())
+	assert.Equal(t, smSize, marshaler.ScopeMetricsSize(emptySM)+deltaSize(mSize))
+	emptyRM := NewResourceMetrics()
+	rm.Resource().CopyTo(emptyRM.Resource())
+	assert.Equal(t, rmSize, marshaler.ResourceMetricsSize(emptyRM)+deltaSize(smSize))
+	assert.Equal(t, marshaler.MetricsSize(md), deltaSize(rmSize))
+
+	hdp := NewHistogramDataPoint()
+	hdp.SetCount(10)
+	assert.Equal(t, hdp.orig.Size(), marshaler.HistogramDataPointSize(hdp))
+	ehdp := NewExponentialHistogramDataPoint()
+	ehdp.SetScale(2)
+	assert.Equal(t, ehdp.orig.Size(), marshaler.ExponentialHistogramDataPointSize(ehdp))
+	sdp := NewSummaryDataPoint()
+	sdp.SetSum(1.5)
+	assert.Equal(t, sdp.orig.Size(), marshaler.SummaryDataPointSize(sdp))
+}
+
+// deltaSize returns the size of a message of the given size encoded as a field of its parent.
+func deltaSize(size int) int {
+	return 1 + (bits.Len64(uint64(size)|1)+6)/7 + size
+}
+
 func BenchmarkMetricsToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	metrics := generateBenchmarkMetrics(128)
@@ -50,6 +50,11 @@ func TestSampleCount(t *testing.T) {
 	}
 	// 5 + 1 (from rms.At(0) initialized first)
 	assert.EqualValues(t, 6, profiles.SampleCount())
+
+	pcs := rms.At(2).ScopeProfiles().At(0).Profiles()
+	pcs.AppendEmpty().Profile().Sample().AppendEmpty()
+	pcs.AppendEmpty().Profile().Sample().AppendEmpty()
+	assert.EqualValues(t, 8, profiles.SampleCount())
 }
 
 func TestSampleCountWithEmpty(t *testing.T) {
@@ -22,6 +22,21 @@ func (e *ProtoMarshaler) TracesSize(td Traces) int {
 	return pb.Size()
 }
 
+// ResourceSpansSize returns the size in bytes of the ResourceSpans once marshaled to OTLP protobuf.
+func (e *ProtoMarshaler) ResourceSpansSize(rs ResourceSpans) int {
+	return rs.orig.Size()
+}
+
+// ScopeSpansSize returns the size in bytes of the ScopeSpans once marshaled to OTLP protobuf.
+func (e *ProtoMarshaler) ScopeSpansSize(ss ScopeSpans) int {
+	return ss.orig.Size()
+}
+
+// SpanSize returns the size in bytes of the Span once marshaled to OTLP protobuf.
+func (e *ProtoMarshaler) SpanSize(span Span) int {
+	return span.orig.Size()
+}
+
 type ProtoUnmarshaler struct{}
 
 func (d *ProtoUnmarshaler) UnmarshalTraces(buf []byte) (Traces, error) {
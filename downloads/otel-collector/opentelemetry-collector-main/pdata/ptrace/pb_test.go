@@ -4,6 +4,7 @@
 package ptrace
 
 import (
+	"math/bits"
 	"testing"
 	"time"
 
@@ -37,6 +38,34 @@ func TestProtoSizerEmptyTraces(t *testing.T) {
 	assert.Equal(t, 0, sizer.TracesSize(NewTraces()))
 }
 
+func TestProtoElementSizers(t *testing.T) {
+	marshaler := &ProtoMarshaler{}
+	td := NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "test")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	span := ss.Spans().AppendEmpty()
+	span.SetName("operation")
+
+	// Every element is encoded in its parent with a one byte tag and its length.
+	spanSize := marshaler.SpanSize(span)
+	ssSize := marshaler.ScopeSpansSize(ss)
+	rsSize := marshaler.ResourceSpansSize(rs)
+	emptySS := NewScopeSpans()
+	ss.Scope().CopyTo(emptySS.Scope())
+	assert.Equal(t, ssSize, marshaler.ScopeSpansSize(emptySS)+deltaSize(spanSize))
+	emptyRS := NewResourceSpans()
+	rs.Resource().CopyTo(emptyRS.Resource())
+	assert.Equal(t, rsSize, marshaler.ResourceSpansSize(emptyRS)+deltaSize(ssSize))
+	assert.Equal(t, marshaler.TracesSize(td), deltaSize(rsSize))
+}
+
+// deltaSize returns the size of a message of the given size encoded as a field of its parent.
+func deltaSize(size int) int {
+	return 1 + (bits.Len64(uint64(size)|1)+6)/7 + size
+}
+
 func BenchmarkTracesToProto(b *testing.B) {
 	marshaler := &ProtoMarshaler{}
 	traces := generateBenchmarkTraces(128)
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/testdata"
 	"go.opentelemetry.io/collector/processor/processortest"
@@ -1129,6 +1130,68 @@ func TestBatchLogProcessor_Shutdown(t *testing.T) {
 	require.Len(t, sink.AllLogs(), 1)
 }
 
+func TestBatchProfilesProcessor_SentBySizeWithMaxSize(t *testing.T) {
+	cfg := Config{
+		Timeout:          time.Minute,
+		SendBatchSize:    20,
+		SendBatchMaxSize: 25,
+	}
+	requestCount := 10
+	profilesPerRequest := 7
+	sink := new(consumertest.ProfilesSink)
+
+	creationSet := processortest.NewNopSettings()
+	batcher, err := newBatchProfilesProcessor(creationSet, sink, &cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	for requestNum := 0; requestNum < requestCount; requestNum++ {
+		pd := testdata.GenerateProfiles(profilesPerRequest)
+		require.NoError(t, batcher.ConsumeProfiles(context.Background(), pd))
+	}
+
+	// Added to test case with empty resources sent.
+	assert.NoError(t, batcher.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+
+	receivedProfiles := sink.AllProfiles()
+	sampleCount := 0
+	for _, pd := range receivedProfiles {
+		require.LessOrEqual(t, pd.SampleCount(), int(cfg.SendBatchMaxSize))
+		sampleCount += pd.SampleCount()
+	}
+	require.Equal(t, requestCount*profilesPerRequest, sampleCount)
+	// 3 batches sent by size (21, 21 and 21 samples) and the rest on shutdown.
+	require.Len(t, receivedProfiles, 4)
+}
+
+func TestBatchProfilesProcessor_Shutdown(t *testing.T) {
+	cfg := Config{
+		Timeout:       3 * time.Second,
+		SendBatchSize: 1000,
+	}
+	requestCount := 5
+	profilesPerRequest := 10
+	sink := new(consumertest.ProfilesSink)
+
+	creationSet := processortest.NewNopSettings()
+	creationSet.MetricsLevel = configtelemetry.LevelDetailed
+	batcher, err := newBatchProfilesProcessor(creationSet, sink, &cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	for requestNum := 0; requestNum < requestCount; requestNum++ {
+		pd := testdata.GenerateProfiles(profilesPerRequest)
+		require.NoError(t, batcher.ConsumeProfiles(context.Background(), pd))
+	}
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+
+	require.Len(t, sink.AllProfiles(), 1)
+	require.Equal(t, requestCount*profilesPerRequest, sink.AllProfiles()[0].SampleCount())
+}
+
 func getTestLogSeverityText(requestNum, index int) string {
 	return fmt.Sprintf("test-log-int-%d-%d", requestNum, index)
 }
@@ -1269,6 +1332,78 @@ func TestBatchProcessorSpansBatchedByMetadata(t *testing.T) {
 	}
 }
 
+type metadataProfilesSink struct {
+	*consumertest.ProfilesSink
+
+	lock                 sync.Mutex
+	sampleCountByToken12 map[string]int
+}
+
+func (mps *metadataProfilesSink) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	info := client.FromContext(ctx)
+	token1 := info.Metadata.Get("token1")
+	token2 := info.Metadata.Get("token2")
+	mps.lock.Lock()
+	defer mps.lock.Unlock()
+
+	mps.sampleCountByToken12[formatTwo(
+		token1,
+		token2,
+	)] += pd.SampleCount()
+	return mps.ProfilesSink.ConsumeProfiles(ctx, pd)
+}
+
+func TestBatchProcessorProfilesBatchedByMetadata(t *testing.T) {
+	sink := &metadataProfilesSink{
+		ProfilesSink:         &consumertest.ProfilesSink{},
+		sampleCountByToken12: map[string]int{},
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.SendBatchSize = 1000
+	cfg.Timeout = 10 * time.Minute
+	cfg.MetadataKeys = []string{"token1", "token2"}
+	creationSet := processortest.NewNopSettings()
+	batcher, err := newBatchProfilesProcessor(creationSet, sink, cfg)
+	require.NoError(t, err)
+	require.NoError(t, batcher.Start(context.Background(), componenttest.NewNopHost()))
+
+	bg := context.Background()
+	callCtxs := []context.Context{
+		client.NewContext(bg, client.Info{
+			Metadata: client.NewMetadata(map[string][]string{
+				"token1": {"single"},
+			}),
+		}),
+		client.NewContext(bg, client.Info{
+			Metadata: client.NewMetadata(map[string][]string{
+				"token1": {"single"},
+				"token2": {"one", "two"},
+			}),
+		}),
+	}
+	expectByContext := make([]int, len(callCtxs))
+
+	requestCount := 100
+	profilesPerRequest := 3
+	for requestNum := 0; requestNum < requestCount; requestNum++ {
+		// use round-robin to assign context.
+		num := requestNum % len(callCtxs)
+		expectByContext[num] += profilesPerRequest
+		require.NoError(t, batcher.ConsumeProfiles(callCtxs[num], testdata.GenerateProfiles(profilesPerRequest)))
+	}
+
+	require.NoError(t, batcher.Shutdown(context.Background()))
+
+	// Each context is batched on its own.
+	require.Len(t, sink.AllProfiles(), len(callCtxs))
+	require.Equal(t, len(callCtxs), len(sink.sampleCountByToken12))
+	for idx, ctx := range callCtxs {
+		md := client.FromContext(ctx).Metadata
+		exp := formatTwo(md.Get("token1"), md.Get("token2"))
+		require.Equal(t, expectByContext[idx], sink.sampleCountByToken12[exp])
+	}
+}
+
 func TestBatchProcessorDuplicateMetadataKeys(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.MetadataKeys = []string{"myTOKEN", "mytoken"}
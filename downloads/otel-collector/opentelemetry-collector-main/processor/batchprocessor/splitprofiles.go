@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchprocessor // import "go.opentelemetry.io/collector/processor/batchprocessor"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// splitProfiles removes samples from the input data and returns a new data of the specified size.
+func splitProfiles(size int, src pprofile.Profiles) pprofile.Profiles {
+	if src.SampleCount() <= size {
+		return src
+	}
+	totalCopiedSamples := 0
+	dest := pprofile.NewProfiles()
+
+	src.ResourceProfiles().RemoveIf(func(srcRp pprofile.ResourceProfiles) bool {
+		// If we are done skip everything else.
+		if totalCopiedSamples == size {
+			return false
+		}
+
+		// If it fully fits
+		srcRpSC := resourcePSC(srcRp)
+		if (totalCopiedSamples + srcRpSC) <= size {
+			totalCopiedSamples += srcRpSC
+			srcRp.MoveTo(dest.ResourceProfiles().AppendEmpty())
+			return true
+		}
+
+		destRp := dest.ResourceProfiles().AppendEmpty()
+		srcRp.Resource().CopyTo(destRp.Resource())
+		srcRp.ScopeProfiles().RemoveIf(func(srcSp pprofile.ScopeProfiles) bool {
+			// If we are done skip everything else.
+			if totalCopiedSamples == size {
+				return false
+			}
+
+			// If possible to move all profiles do that.
+			srcSpSC := scopePSC(srcSp)
+			if size >= srcSpSC+totalCopiedSamples {
+				totalCopiedSamples += srcSpSC
+				srcSp.MoveTo(destRp.ScopeProfiles().AppendEmpty())
+				return true
+			}
+
+			destSp := destRp.ScopeProfiles().AppendEmpty()
+			srcSp.Scope().CopyTo(destSp.Scope())
+			srcSp.Profiles().RemoveIf(func(srcPc pprofile.ProfileContainer) bool {
+				// If we are done skip everything else.
+				if totalCopiedSamples == size {
+					return false
+				}
+
+				// If possible to move the whole profile do that.
+				srcPcSC := srcPc.Profile().Sample().Len()
+				if size >= srcPcSC+totalCopiedSamples {
+					totalCopiedSamples += srcPcSC
+					srcPc.MoveTo(destSp.Profiles().AppendEmpty())
+					return true
+				}
+
+				// Samples refer to the lookup tables of their profile by index,
+				// so everything but the samples is copied to the new profile.
+				destPc := destSp.Profiles().AppendEmpty()
+				samples := pprofile.NewSampleSlice()
+				srcPc.Profile().Sample().MoveAndAppendTo(samples)
+				srcPc.CopyTo(destPc)
+				samples.MoveAndAppendTo(srcPc.Profile().Sample())
+				srcPc.Profile().Sample().RemoveIf(func(srcSample pprofile.Sample) bool {
+					// If we are done skip everything else.
+					if totalCopiedSamples == size {
+						return false
+					}
+					srcSample.MoveTo(destPc.Profile().Sample().AppendEmpty())
+					totalCopiedSamples++
+					return true
+				})
+				return false
+			})
+			return false
+		})
+		return srcRp.ScopeProfiles().Len() == 0
+	})
+
+	return dest
+}
+
+// resourcePSC calculates the total number of samples in the pprofile.ResourceProfiles.
+func resourcePSC(rp pprofile.ResourceProfiles) (count int) {
+	for k := 0; k < rp.ScopeProfiles().Len(); k++ {
+		count += scopePSC(rp.ScopeProfiles().At(k))
+	}
+	return
+}
+
+// scopePSC calculates the total number of samples in the pprofile.ScopeProfiles.
+func scopePSC(sp pprofile.ScopeProfiles) (count int) {
+	for k := 0; k < sp.Profiles().Len(); k++ {
+		count += sp.Profiles().At(k).Profile().Sample().Len()
+	}
+	return
+}
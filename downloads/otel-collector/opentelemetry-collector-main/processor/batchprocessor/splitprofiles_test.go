@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestSplitProfiles_noop(t *testing.T) {
+	td := testdata.GenerateProfiles(20)
+	splitSize := 40
+	split := splitProfiles(splitSize, td)
+	assert.Equal(t, td, split)
+
+	i := 0
+	td.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().RemoveIf(func(pprofile.ProfileContainer) bool {
+		i++
+		return i > 5
+	})
+	assert.EqualValues(t, td, split)
+}
+
+func TestSplitProfiles(t *testing.T) {
+	td := testdata.GenerateProfiles(20)
+	profiles := td.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+	for i := 0; i < profiles.Len(); i++ {
+		profiles.At(i).Profile().Sample().At(0).SetLink(uint64(i))
+	}
+	cp := pprofile.NewProfiles()
+	cpProfiles := cp.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles()
+	cpProfiles.EnsureCapacity(5)
+	td.ResourceProfiles().At(0).Resource().CopyTo(
+		cp.ResourceProfiles().At(0).Resource())
+	td.ResourceProfiles().At(0).ScopeProfiles().At(0).Scope().CopyTo(
+		cp.ResourceProfiles().At(0).ScopeProfiles().At(0).Scope())
+	for i := 0; i < 5; i++ {
+		profiles.At(i).CopyTo(cpProfiles.AppendEmpty())
+	}
+
+	splitSize := 5
+	split := splitProfiles(splitSize, td)
+	assert.Equal(t, splitSize, split.SampleCount())
+	assert.Equal(t, cp, split)
+	assert.Equal(t, 15, td.SampleCount())
+	assert.EqualValues(t, 0, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile().Sample().At(0).Link())
+	assert.EqualValues(t, 4, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(4).Profile().Sample().At(0).Link())
+
+	split = splitProfiles(splitSize, td)
+	assert.Equal(t, 10, td.SampleCount())
+	assert.EqualValues(t, 5, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile().Sample().At(0).Link())
+	assert.EqualValues(t, 9, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(4).Profile().Sample().At(0).Link())
+
+	split = splitProfiles(splitSize, td)
+	assert.Equal(t, 5, td.SampleCount())
+	assert.EqualValues(t, 10, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile().Sample().At(0).Link())
+	assert.EqualValues(t, 14, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(4).Profile().Sample().At(0).Link())
+
+	split = splitProfiles(splitSize, td)
+	assert.Equal(t, 5, td.SampleCount())
+	assert.EqualValues(t, 15, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile().Sample().At(0).Link())
+	assert.EqualValues(t, 19, split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(4).Profile().Sample().At(0).Link())
+}
+
+func TestSplitProfilesMultipleResourceProfiles(t *testing.T) {
+	td := testdata.GenerateProfiles(20)
+	// add second index to resource profiles
+	testdata.GenerateProfiles(20).
+		ResourceProfiles().At(0).CopyTo(td.ResourceProfiles().AppendEmpty())
+
+	splitSize := 30
+	split := splitProfiles(splitSize, td)
+	assert.Equal(t, splitSize, split.SampleCount())
+	assert.Equal(t, 10, td.SampleCount())
+	assert.Equal(t, 2, split.ResourceProfiles().Len())
+	assert.Equal(t, 1, td.ResourceProfiles().Len())
+	assert.Equal(t, 10, split.ResourceProfiles().At(1).ScopeProfiles().At(0).Profiles().Len())
+}
+
+func TestSplitProfilesWithinProfile(t *testing.T) {
+	td := testdata.GenerateProfiles(1)
+	profile := td.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile()
+	profile.StringTable().Append("", "cpu")
+	for i := 1; i < 10; i++ {
+		profile.Sample().AppendEmpty().SetLink(uint64(i))
+	}
+
+	splitSize := 4
+	split := splitProfiles(splitSize, td)
+	assert.Equal(t, splitSize, split.SampleCount())
+	assert.Equal(t, 6, td.SampleCount())
+
+	// The lookup tables are kept in both halves as samples refer to them by index.
+	splitProfile := split.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).Profile()
+	assert.Equal(t, profile.StringTable().AsRaw(), splitProfile.StringTable().AsRaw())
+	assert.EqualValues(t, 3, splitProfile.Sample().At(3).Link())
+	assert.EqualValues(t, 4, profile.Sample().At(0).Link())
+	assert.Equal(t, 1, td.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().Len())
+}
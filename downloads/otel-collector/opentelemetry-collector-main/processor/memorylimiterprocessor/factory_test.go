@@ -55,9 +55,15 @@ func TestCreateProcessor(t *testing.T) {
 	assert.NotNil(t, lp)
 	assert.NoError(t, lp.Start(context.Background(), componenttest.NewNopHost()))
 
+	pp, err := factory.CreateProfilesProcessor(context.Background(), processortest.NewNopSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.NotNil(t, pp)
+	assert.NoError(t, pp.Start(context.Background(), componenttest.NewNopHost()))
+
 	assert.NoError(t, lp.Shutdown(context.Background()))
 	assert.NoError(t, tp.Shutdown(context.Background()))
 	assert.NoError(t, mp.Shutdown(context.Background()))
+	assert.NoError(t, pp.Shutdown(context.Background()))
 	// verify that no monitoring routine is running
 	require.ErrorIs(t, tp.Shutdown(context.Background()), memorylimiter.ErrShutdownNotStarted)
 
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/internal/memorylimiter"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
@@ -109,3 +110,11 @@ func (p *memoryLimiterProcessor) processLogs(ctx context.Context, ld plog.Logs)
 	p.obsrep.LogsAccepted(ctx, numRecords)
 	return ld, nil
 }
+
+func (p *memoryLimiterProcessor) processProfiles(_ context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+	if p.memlimiter.MustRefuse() {
+		// The profiles have no refused metric: processorhelperprofiles only records the incoming and outgoing items.
+		return pd, memorylimiter.ErrDataRefused
+	}
+	return pd, nil
+}
@@ -19,10 +19,12 @@ import (
 	"go.opentelemetry.io/collector/internal/memorylimiter"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/memorylimiterprocessor/internal"
 	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processorhelper/processorhelperprofiles"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -384,6 +386,96 @@ func TestLogMemoryPressureResponse(t *testing.T) {
 	})
 }
 
+// TestProfileMemoryPressureResponse manipulates results from querying memory and
+// check expected side effects.
+func TestProfileMemoryPressureResponse(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		mlCfg       *Config
+		memAlloc    uint64
+		expectError bool
+	}{
+		{
+			name: "Below memAllocLimit",
+			mlCfg: &Config{
+				CheckInterval:         time.Second,
+				MemoryLimitPercentage: 50,
+				MemorySpikePercentage: 1,
+			},
+			memAlloc:    800,
+			expectError: false,
+		},
+		{
+			name: "Above memAllocLimit",
+			mlCfg: &Config{
+				CheckInterval:         time.Second,
+				MemoryLimitPercentage: 50,
+				MemorySpikePercentage: 1,
+			},
+			memAlloc:    1800,
+			expectError: true,
+		},
+		{
+			name: "Below memSpikeLimit",
+			mlCfg: &Config{
+				CheckInterval:         time.Second,
+				MemoryLimitPercentage: 50,
+				MemorySpikePercentage: 10,
+			},
+			memAlloc:    800,
+			expectError: false,
+		},
+		{
+			name: "Above memSpikeLimit",
+			mlCfg: &Config{
+				CheckInterval:         time.Second,
+				MemoryLimitPercentage: 50,
+				MemorySpikePercentage: 11,
+			},
+			memAlloc:    800,
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memorylimiter.GetMemoryFn = totalMemory
+			memorylimiter.ReadMemStatsFn = func(ms *runtime.MemStats) {
+				ms.Alloc = tt.memAlloc
+			}
+
+			ml, err := newMemoryLimiterProcessor(processortest.NewNopSettings(), tt.mlCfg)
+			require.NoError(t, err)
+			tp, err := processorhelperprofiles.NewProfilesProcessor(
+				context.Background(),
+				processortest.NewNopSettings(),
+				tt.mlCfg,
+				consumertest.NewNop(),
+				ml.processProfiles,
+				processorhelperprofiles.WithCapabilities(processorCapabilities),
+				processorhelperprofiles.WithStart(ml.start),
+				processorhelperprofiles.WithShutdown(ml.shutdown))
+			require.NoError(t, err)
+
+			assert.NoError(t, tp.Start(ctx, &host{}))
+			ml.memlimiter.CheckMemLimits()
+			err = tp.ConsumeProfiles(ctx, pd)
+			if tt.expectError {
+				assert.Equal(t, memorylimiter.ErrDataRefused, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, tp.Shutdown(ctx))
+		})
+	}
+	t.Cleanup(func() {
+		memorylimiter.GetMemoryFn = iruntime.TotalMemory
+		memorylimiter.ReadMemStatsFn = runtime.ReadMemStats
+	})
+}
+
 type host struct {
 	component.Host
 }
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processorhelperprofiles // import "go.opentelemetry.io/collector/processor/processorhelper/processorhelperprofiles"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/internal"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processorhelper/internal/metadata"
+	"go.opentelemetry.io/collector/processor/processorprofiles"
+)
+
+// ProcessProfilesFunc is a helper function that processes the incoming data and returns the data to be sent to the next component.
+// If error is returned then returned data are ignored. It MUST not call the next component.
+type ProcessProfilesFunc func(context.Context, pprofile.Profiles) (pprofile.Profiles, error)
+
+type profilesProcessor struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumerprofiles.Profiles
+}
+
+// NewProfilesProcessor creates a processorprofiles.Profiles that ensure context propagation and the right tags are set.
+// Like the processors of the other signals, it records the number of profile samples passed to the processor and
+// emitted from it in the otelcol_processor_incoming_items and otelcol_processor_outgoing_items metrics.
+func NewProfilesProcessor(
+	_ context.Context,
+	set processor.Settings,
+	_ component.Config,
+	nextConsumer consumerprofiles.Profiles,
+	profilesFunc ProcessProfilesFunc,
+	options ...Option,
+) (processorprofiles.Profiles, error) {
+	if profilesFunc == nil {
+		return nil, errors.New("nil profilesFunc")
+	}
+
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	otelAttrs := metric.WithAttributes(
+		attribute.String(internal.ProcessorKey, set.ID.String()),
+		attribute.String("otel.signal", "profiles"),
+	)
+
+	eventOptions := trace.WithAttributes(attribute.String(internal.ProcessorKey, set.ID.String()))
+	bs := fromOptions(options)
+	profilesConsumer, err := consumerprofiles.NewProfiles(func(ctx context.Context, pd pprofile.Profiles) error {
+		span := trace.SpanFromContext(ctx)
+		span.AddEvent("Start processing.", eventOptions)
+		samplesIn := pd.SampleCount()
+
+		pd, err = profilesFunc(ctx, pd)
+		span.AddEvent("End processing.", eventOptions)
+		if err != nil {
+			if errors.Is(err, processorhelper.ErrSkipProcessingData) {
+				return nil
+			}
+			return err
+		}
+		samplesOut := pd.SampleCount()
+		telemetryBuilder.ProcessorIncomingItems.Add(ctx, int64(samplesIn), otelAttrs)
+		telemetryBuilder.ProcessorOutgoingItems.Add(ctx, int64(samplesOut), otelAttrs)
+		return nextConsumer.ConsumeProfiles(ctx, pd)
+	}, bs.consumerOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profilesProcessor{
+		StartFunc:    bs.StartFunc,
+		ShutdownFunc: bs.ShutdownFunc,
+		Profiles:     profilesConsumer,
+	}, nil
+}
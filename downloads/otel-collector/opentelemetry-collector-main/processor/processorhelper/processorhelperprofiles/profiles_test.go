@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processorhelperprofiles
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/testdata"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+var testProfilesCfg = struct{}{}
+
+func TestNewProfilesProcessor(t *testing.T) {
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(nil))
+	require.NoError(t, err)
+
+	assert.True(t, pp.Capabilities().MutatesData)
+	assert.NoError(t, pp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+	assert.NoError(t, pp.Shutdown(context.Background()))
+}
+
+func TestNewProfilesProcessor_WithOptions(t *testing.T) {
+	want := errors.New("my_error")
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(nil),
+		WithStart(func(context.Context, component.Host) error { return want }),
+		WithShutdown(func(context.Context) error { return want }),
+		WithCapabilities(consumer.Capabilities{MutatesData: false}))
+	require.NoError(t, err)
+
+	assert.Equal(t, want, pp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, want, pp.Shutdown(context.Background()))
+	assert.False(t, pp.Capabilities().MutatesData)
+}
+
+func TestNewProfilesProcessor_NilRequiredFields(t *testing.T) {
+	_, err := NewProfilesProcessor(context.Background(), processortest.NewNopSettings(), &testProfilesCfg, consumertest.NewNop(), nil)
+	assert.Error(t, err)
+}
+
+func TestNewProfilesProcessor_ProcessProfileError(t *testing.T) {
+	want := errors.New("my_error")
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(want))
+	require.NoError(t, err)
+	assert.Equal(t, want, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+}
+
+func TestNewProfilesProcessor_ProcessProfilesErrSkipProcessingData(t *testing.T) {
+	pp, err := NewProfilesProcessor(context.Background(), processortest.NewNopSettings(), &testProfilesCfg, consumertest.NewNop(), newTestPProcessor(processorhelper.ErrSkipProcessingData))
+	require.NoError(t, err)
+	assert.NoError(t, pp.ConsumeProfiles(context.Background(), pprofile.NewProfiles()))
+}
+
+func newTestPProcessor(retError error) ProcessProfilesFunc {
+	return func(_ context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+		return pd, retError
+	}
+}
+
+func TestProfilesProcessor_RecordInOut(t *testing.T) {
+	// Regardless of how many profiles are ingested, emit just one with a single sample.
+	mockAggregate := func(_ context.Context, _ pprofile.Profiles) (pprofile.Profiles, error) {
+		pd := pprofile.NewProfiles()
+		pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Profile().Sample().AppendEmpty()
+		return pd, nil
+	}
+
+	incomingProfiles := testdata.GenerateProfiles(2)
+	require.Equal(t, 2, incomingProfiles.SampleCount())
+
+	reader := sdkmetric.NewManualReader()
+	set := processortest.NewNopSettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	set.LeveledMeterProvider = func(configtelemetry.Level) metric.MeterProvider {
+		return set.MeterProvider
+	}
+	pp, err := NewProfilesProcessor(context.Background(), set, &testProfilesCfg, consumertest.NewNop(), mockAggregate)
+	require.NoError(t, err)
+
+	assert.NoError(t, pp.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, pp.ConsumeProfiles(context.Background(), incomingProfiles))
+	assert.NoError(t, pp.Shutdown(context.Background()))
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &md))
+	require.Len(t, md.ScopeMetrics, 1)
+	attrs := attribute.NewSet(attribute.String("processor", set.ID.String()), attribute.String("otel.signal", "profiles"))
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope: md.ScopeMetrics[0].Scope,
+		Metrics: []metricdata.Metrics{
+			{
+				Name:        "otelcol_processor_incoming_items",
+				Description: "Number of items passed to the processor. [alpha]",
+				Unit:        "{items}",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Value: 2, Attributes: attrs}},
+				},
+			},
+			{
+				Name:        "otelcol_processor_outgoing_items",
+				Description: "Number of items emitted from the processor. [alpha]",
+				Unit:        "{items}",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Value: 1, Attributes: attrs}},
+				},
+			},
+		},
+	}, md.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
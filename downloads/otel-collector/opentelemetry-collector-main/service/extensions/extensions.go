@@ -246,3 +246,7 @@ func (r *nopReporter) Ready() {}
 func (r *nopReporter) ReportStatus(*componentstatus.InstanceID, *componentstatus.Event) {}
 
 func (r *nopReporter) ReportOKIfStarting(*componentstatus.InstanceID) {}
+
+func (r *nopReporter) Status(*componentstatus.InstanceID) componentstatus.Status {
+	return componentstatus.StatusNone
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/connector/connectorprofiles"
 	"go.opentelemetry.io/collector/connector/connectortest"
@@ -339,6 +340,67 @@ func (b *ConnectorBuilder) Factory(componentType component.Type) component.Facto
 	return b.factories[componentType]
 }
 
+// Stability returns the stability level of the connector of the given type, from the data type it is used as an
+// exporter for to the data type it is used as a receiver for. It returns component.StabilityLevelUndefined if the
+// type or the data types are not supported.
+func (b *ConnectorBuilder) Stability(componentType component.Type, exprType, rcvrType component.DataType) component.StabilityLevel {
+	f, existsFactory := b.factories[componentType]
+	if !existsFactory {
+		return component.StabilityLevelUndefined
+	}
+	switch exprType {
+	case component.DataTypeTraces:
+		switch rcvrType {
+		case component.DataTypeTraces:
+			return f.TracesToTracesStability()
+		case component.DataTypeMetrics:
+			return f.TracesToMetricsStability()
+		case component.DataTypeLogs:
+			return f.TracesToLogsStability()
+		case componentprofiles.DataTypeProfiles:
+			return f.TracesToProfilesStability()
+		}
+	case component.DataTypeMetrics:
+		switch rcvrType {
+		case component.DataTypeTraces:
+			return f.MetricsToTracesStability()
+		case component.DataTypeMetrics:
+			return f.MetricsToMetricsStability()
+		case component.DataTypeLogs:
+			return f.MetricsToLogsStability()
+		case componentprofiles.DataTypeProfiles:
+			return f.MetricsToProfilesStability()
+		}
+	case component.DataTypeLogs:
+		switch rcvrType {
+		case component.DataTypeTraces:
+			return f.LogsToTracesStability()
+		case component.DataTypeMetrics:
+			return f.LogsToMetricsStability()
+		case component.DataTypeLogs:
+			return f.LogsToLogsStability()
+		case componentprofiles.DataTypeProfiles:
+			return f.LogsToProfilesStability()
+		}
+	case componentprofiles.DataTypeProfiles:
+		switch rcvrType {
+		case component.DataTypeTraces:
+			return f.ProfilesToTracesStability()
+		case component.DataTypeMetrics:
+			return f.ProfilesToMetricsStability()
+		case component.DataTypeLogs:
+			return f.ProfilesToLogsStability()
+		case componentprofiles.DataTypeProfiles:
+			return f.ProfilesToProfilesStability()
+		}
+	}
+	return component.StabilityLevelUndefined
+}
+
+func (b *ConnectorBuilder) Config(componentID component.ID) component.Config {
+	return b.cfgs[componentID]
+}
+
 // NewNopConnectorConfigsAndFactories returns a configuration and factories that allows building a new nop connector.
 func NewNopConnectorConfigsAndFactories() (map[component.ID]component.Config, map[component.Type]connector.Factory) {
 	nopFactory := connectortest.NewNopFactory()
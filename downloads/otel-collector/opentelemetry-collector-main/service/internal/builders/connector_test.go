@@ -366,6 +366,19 @@ func TestConnectorBuilderGetters(t *testing.T) {
 
 	assert.NotNil(t, b.Factory(component.MustNewID("foo").Type()))
 	assert.Nil(t, b.Factory(component.MustNewID("bar").Type()))
+
+	assert.Equal(t, struct{}{}, b.Config(component.MustNewID("foo")))
+	assert.Nil(t, b.Config(component.MustNewID("bar")))
+}
+
+func TestConnectorBuilderStability(t *testing.T) {
+	configs, factories := NewNopConnectorConfigsAndFactories()
+	b := NewConnector(configs, factories)
+
+	assert.Equal(t, component.StabilityLevelDevelopment, b.Stability(nopType, component.DataTypeTraces, component.DataTypeMetrics))
+	assert.Equal(t, component.StabilityLevelAlpha, b.Stability(nopType, componentprofiles.DataTypeProfiles, component.DataTypeLogs))
+	assert.Equal(t, component.StabilityLevelUndefined, b.Stability(nopType, component.DataTypeTraces, component.MustNewType("unknown")))
+	assert.Equal(t, component.StabilityLevelUndefined, b.Stability(component.MustNewType("bar"), component.DataTypeTraces, component.DataTypeTraces))
 }
 
 func TestNewNopConnectorConfigsAndFactories(t *testing.T) {
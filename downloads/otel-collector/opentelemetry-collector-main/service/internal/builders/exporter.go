@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterprofiles"
 	"go.opentelemetry.io/collector/exporter/exportertest"
@@ -92,6 +93,30 @@ func (b *ExporterBuilder) Factory(componentType component.Type) component.Factor
 	return b.factories[componentType]
 }
 
+// Stability returns the stability level of the exporter of the given type for the given data type,
+// component.StabilityLevelUndefined if the type or the data type is not supported.
+func (b *ExporterBuilder) Stability(componentType component.Type, dataType component.DataType) component.StabilityLevel {
+	f, existsFactory := b.factories[componentType]
+	if !existsFactory {
+		return component.StabilityLevelUndefined
+	}
+	switch dataType {
+	case component.DataTypeTraces:
+		return f.TracesExporterStability()
+	case component.DataTypeMetrics:
+		return f.MetricsExporterStability()
+	case component.DataTypeLogs:
+		return f.LogsExporterStability()
+	case componentprofiles.DataTypeProfiles:
+		return f.ProfilesExporterStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func (b *ExporterBuilder) Config(componentID component.ID) component.Config {
+	return b.cfgs[componentID]
+}
+
 // NewNopExporterConfigsAndFactories returns a configuration and factories that allows building a new nop exporter.
 func NewNopExporterConfigsAndFactories() (map[component.ID]component.Config, map[component.Type]exporter.Factory) {
 	nopFactory := exportertest.NewNopFactory()
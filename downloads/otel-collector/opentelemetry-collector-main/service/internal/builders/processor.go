@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumerprofiles"
 	"go.opentelemetry.io/collector/processor"
@@ -107,6 +108,30 @@ func (b *ProcessorBuilder) Factory(componentType component.Type) component.Facto
 	return b.factories[componentType]
 }
 
+// Stability returns the stability level of the processor of the given type for the given data type,
+// component.StabilityLevelUndefined if the type or the data type is not supported.
+func (b *ProcessorBuilder) Stability(componentType component.Type, dataType component.DataType) component.StabilityLevel {
+	f, existsFactory := b.factories[componentType]
+	if !existsFactory {
+		return component.StabilityLevelUndefined
+	}
+	switch dataType {
+	case component.DataTypeTraces:
+		return f.TracesProcessorStability()
+	case component.DataTypeMetrics:
+		return f.MetricsProcessorStability()
+	case component.DataTypeLogs:
+		return f.LogsProcessorStability()
+	case componentprofiles.DataTypeProfiles:
+		return f.ProfilesProcessorStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func (b *ProcessorBuilder) Config(componentID component.ID) component.Config {
+	return b.cfgs[componentID]
+}
+
 // NewNopProcessorConfigsAndFactories returns a configuration and factories that allows building a new nop processor.
 func NewNopProcessorConfigsAndFactories() (map[component.ID]component.Config, map[component.Type]processor.Factory) {
 	nopFactory := processortest.NewNopFactory()
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumerprofiles"
@@ -177,6 +178,19 @@ func TestProcessorBuilderFactory(t *testing.T) {
 
 	assert.NotNil(t, b.Factory(component.MustNewID("foo").Type()))
 	assert.Nil(t, b.Factory(component.MustNewID("bar").Type()))
+
+	assert.Equal(t, struct{}{}, b.Config(component.MustNewID("foo")))
+	assert.Nil(t, b.Config(component.MustNewID("bar")))
+}
+
+func TestProcessorBuilderStability(t *testing.T) {
+	configs, factories := NewNopProcessorConfigsAndFactories()
+	b := NewProcessor(configs, factories)
+
+	assert.Equal(t, component.StabilityLevelStable, b.Stability(nopType, component.DataTypeTraces))
+	assert.Equal(t, component.StabilityLevelAlpha, b.Stability(nopType, componentprofiles.DataTypeProfiles))
+	assert.Equal(t, component.StabilityLevelUndefined, b.Stability(nopType, component.MustNewType("unknown")))
+	assert.Equal(t, component.StabilityLevelUndefined, b.Stability(component.MustNewType("bar"), component.DataTypeTraces))
 }
 
 func TestNewNopProcessorBuilder(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentprofiles"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumerprofiles"
 	"go.opentelemetry.io/collector/receiver"
@@ -108,6 +109,30 @@ func (b *ReceiverBuilder) Factory(componentType component.Type) component.Factor
 	return b.factories[componentType]
 }
 
+// Stability returns the stability level of the receiver of the given type for the given data type,
+// component.StabilityLevelUndefined if the type or the data type is not supported.
+func (b *ReceiverBuilder) Stability(componentType component.Type, dataType component.DataType) component.StabilityLevel {
+	f, existsFactory := b.factories[componentType]
+	if !existsFactory {
+		return component.StabilityLevelUndefined
+	}
+	switch dataType {
+	case component.DataTypeTraces:
+		return f.TracesReceiverStability()
+	case component.DataTypeMetrics:
+		return f.MetricsReceiverStability()
+	case component.DataTypeLogs:
+		return f.LogsReceiverStability()
+	case componentprofiles.DataTypeProfiles:
+		return f.ProfilesReceiverStability()
+	}
+	return component.StabilityLevelUndefined
+}
+
+func (b *ReceiverBuilder) Config(componentID component.ID) component.Config {
+	return b.cfgs[componentID]
+}
+
 // NewNopReceiverConfigsAndFactories returns a configuration and factories that allows building a new nop receiver.
 func NewNopReceiverConfigsAndFactories() (map[component.ID]component.Config, map[component.Type]receiver.Factory) {
 	nopFactory := receivertest.NewNopFactory()
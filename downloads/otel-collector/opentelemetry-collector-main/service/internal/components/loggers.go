@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/service/internal/loglevels"
 )
 
 const (
@@ -22,34 +23,34 @@ const (
 )
 
 func ReceiverLogger(logger *zap.Logger, id component.ID, dt component.DataType) *zap.Logger {
-	return logger.With(
+	return loglevels.ComponentLogger(logger, component.KindReceiver, id).With(
 		zap.String(zapKindKey, strings.ToLower(component.KindReceiver.String())),
 		zap.String(zapNameKey, id.String()),
 		zap.String(zapDataTypeKey, dt.String()))
 }
 
 func ProcessorLogger(logger *zap.Logger, id component.ID, pipelineID component.ID) *zap.Logger {
-	return logger.With(
+	return loglevels.ComponentLogger(logger, component.KindProcessor, id).With(
 		zap.String(zapKindKey, strings.ToLower(component.KindProcessor.String())),
 		zap.String(zapNameKey, id.String()),
 		zap.String(zapPipelineKey, pipelineID.String()))
 }
 
 func ExporterLogger(logger *zap.Logger, id component.ID, dt component.DataType) *zap.Logger {
-	return logger.With(
+	return loglevels.ComponentLogger(logger, component.KindExporter, id).With(
 		zap.String(zapKindKey, strings.ToLower(component.KindExporter.String())),
 		zap.String(zapDataTypeKey, dt.String()),
 		zap.String(zapNameKey, id.String()))
 }
 
 func ExtensionLogger(logger *zap.Logger, id component.ID) *zap.Logger {
-	return logger.With(
+	return loglevels.ComponentLogger(logger, component.KindExtension, id).With(
 		zap.String(zapKindKey, strings.ToLower(component.KindExtension.String())),
 		zap.String(zapNameKey, id.String()))
 }
 
 func ConnectorLogger(logger *zap.Logger, id component.ID, expDT, rcvDT component.DataType) *zap.Logger {
-	return logger.With(
+	return loglevels.ComponentLogger(logger, component.KindConnector, id).With(
 		zap.String(zapKindKey, strings.ToLower(component.KindConnector.String())),
 		zap.String(zapNameKey, id.String()),
 		zap.String(zapExporterInPipeline, expDT.String()),
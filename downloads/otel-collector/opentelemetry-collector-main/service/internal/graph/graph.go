@@ -10,12 +10,16 @@
 // [Graph.StartAll] starts all components in each pipeline.
 //
 // [Graph.ShutdownAll] stops all components in each pipeline.
+//
+// [Graph.Rebuild] builds the graph of a new configuration, reusing the running components that are not affected by
+// the changes. [Graph.ShutdownReplaced] then stops the components of the previous graph that were not reused.
 package graph // import "go.opentelemetry.io/collector/service/internal/graph"
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"go.uber.org/multierr"
@@ -50,6 +54,9 @@ type Settings struct {
 	// PipelineConfigs is a map of component.ID to PipelineConfig.
 	PipelineConfigs pipelines.Config
 
+	// EnableTaps wraps the consumers given to the nodes in edge taps, so the tapz zPage can attach taps to the edges.
+	EnableTaps bool
+
 	ReportStatus status.ServiceStatusFunc
 }
 
@@ -63,17 +70,173 @@ type Graph struct {
 	// Keep track of status source per node
 	instanceIDs map[int64]*componentstatus.InstanceID
 
+	// Keep track of the consumers given to the nodes to send data to the next nodes, to attach taps to the edges.
+	edgeTaps map[edgeKey]*edgeTap
+
+	// Keep track of the nodes reused from the graph this graph was rebuilt from.
+	// Their components are already running, so they are not started again.
+	reused map[int64]graph.Node
+
 	telemetry component.TelemetrySettings
+
+	// Keep the settings to compare the configuration of the components when the graph is rebuilt.
+	settings Settings
 }
 
 // Build builds a full pipeline graph.
 // Build also validates the configuration of the pipelines and does the actual initialization of each Component in the Graph.
 func Build(ctx context.Context, set Settings) (*Graph, error) {
+	pipelines, err := newGraph(set)
+	if err != nil {
+		return nil, err
+	}
+	return pipelines, pipelines.buildComponents(ctx, set)
+}
+
+// Rebuild builds the pipeline graph of the given settings, like Build, but reuses the components of g that are
+// not affected by the changes: a component is reused if its configuration is unchanged and if all the components
+// it sends data to are reused too. Hence, only the changed components and the components upstream of them are
+// created again, while the reused ones keep running.
+//
+// Rebuild does not start or stop any component: [Graph.ShutdownReplaced] must be called on g to stop the components
+// that were not reused, before calling [Graph.StartAll] on the returned graph to start the new ones.
+func (g *Graph) Rebuild(ctx context.Context, set Settings) (*Graph, error) {
+	next, err := newGraph(set)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := topo.Sort(next.componentGraph)
+	if err != nil {
+		return nil, cycleErr(err, topo.DirectedCyclesIn(next.componentGraph))
+	}
+	// Visit the downstream nodes first, so the successors of a node are checked before it.
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		if !g.canReuse(next, node) {
+			continue
+		}
+		next.reused[node.ID()] = g.componentGraph.Node(node.ID())
+	}
+	g.replaceSharedComponents(next)
+	for nodeID := range next.reused {
+		if instanceID, ok := g.instanceIDs[nodeID]; ok {
+			// The status of the running component keeps being reported with its original instance ID.
+			next.instanceIDs[nodeID] = instanceID
+		}
+		// The running component keeps sending data through the consumers it was created with.
+		for key, et := range g.edgeTaps {
+			if key.from == nodeID {
+				next.edgeTaps[key] = et
+			}
+		}
+	}
+	return next, next.buildComponents(ctx, set)
+}
+
+// replaceSharedComponents ensures that the nodes of a same component, like a receiver used in pipelines of different
+// types, are either all reused or all replaced, since they may share the same underlying instance that is stopped
+// as soon as one of them is shut down.
+func (g *Graph) replaceSharedComponents(next *Graph) {
+	for {
+		replaced := make(map[string]struct{})
+		for _, nodes := range []graph.Nodes{g.componentGraph.Nodes(), next.componentGraph.Nodes()} {
+			for nodes.Next() {
+				key, ok := componentKey(nodes.Node())
+				if _, reused := next.reused[nodes.Node().ID()]; ok && !reused {
+					replaced[key] = struct{}{}
+				}
+			}
+		}
+
+		changed := false
+		for nodeID, node := range next.reused {
+			if key, ok := componentKey(node); ok {
+				if _, ok = replaced[key]; ok {
+					next.replace(nodeID)
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+}
+
+// replace marks the node as not reused, as well as the nodes upstream of it.
+func (g *Graph) replace(nodeID int64) {
+	if _, ok := g.reused[nodeID]; !ok {
+		return
+	}
+	delete(g.reused, nodeID)
+	prev := g.componentGraph.To(nodeID)
+	for prev.Next() {
+		g.replace(prev.Node().ID())
+	}
+}
+
+// componentKey returns a key identifying the component of the node, or false for the nodes without component.
+func componentKey(node graph.Node) (string, bool) {
+	switch n := node.(type) {
+	case *receiverNode:
+		return component.KindReceiver.String() + "/" + n.componentID.String(), true
+	case *processorNode:
+		return component.KindProcessor.String() + "/" + n.componentID.String(), true
+	case *exporterNode:
+		return component.KindExporter.String() + "/" + n.componentID.String(), true
+	case *connectorNode:
+		return component.KindConnector.String() + "/" + n.componentID.String(), true
+	}
+	return "", false
+}
+
+// canReuse returns whether the component of the given node of the next graph can be reused from g.
+func (g *Graph) canReuse(next *Graph, node graph.Node) bool {
+	prev := g.componentGraph.Node(node.ID())
+	if prev == nil || !reflect.DeepEqual(g.settings.componentConfig(prev), next.settings.componentConfig(node)) {
+		return false
+	}
+	// The consumers of a node are given to its component when it is created, so they must be the same.
+	succ := next.componentGraph.From(node.ID())
+	if succ.Len() != g.componentGraph.From(node.ID()).Len() {
+		return false
+	}
+	for succ.Next() {
+		if _, ok := next.reused[succ.Node().ID()]; !ok {
+			return false
+		}
+		if !g.componentGraph.HasEdgeFromTo(node.ID(), succ.Node().ID()) {
+			return false
+		}
+	}
+	return true
+}
+
+// componentConfig returns the configuration of the component of the given node, or nil for the nodes without component.
+func (set Settings) componentConfig(node graph.Node) component.Config {
+	switch n := node.(type) {
+	case *receiverNode:
+		return set.ReceiverBuilder.Config(n.componentID)
+	case *processorNode:
+		return set.ProcessorBuilder.Config(n.componentID)
+	case *exporterNode:
+		return set.ExporterBuilder.Config(n.componentID)
+	case *connectorNode:
+		return set.ConnectorBuilder.Config(n.componentID)
+	}
+	return nil
+}
+
+// newGraph creates the nodes and edges of the graph, without building the components.
+func newGraph(set Settings) (*Graph, error) {
 	pipelines := &Graph{
 		componentGraph: simple.NewDirectedGraph(),
 		pipelines:      make(map[component.ID]*pipelineNodes, len(set.PipelineConfigs)),
 		instanceIDs:    make(map[int64]*componentstatus.InstanceID),
+		edgeTaps:       make(map[edgeKey]*edgeTap),
+		reused:         make(map[int64]graph.Node),
 		telemetry:      set.Telemetry,
+		settings:       set,
 	}
 	for pipelineID := range set.PipelineConfigs {
 		pipelines.pipelines[pipelineID] = &pipelineNodes{
@@ -85,7 +248,7 @@ func Build(ctx context.Context, set Settings) (*Graph, error) {
 		return nil, err
 	}
 	pipelines.createEdges()
-	return pipelines, pipelines.buildComponents(ctx, set)
+	return pipelines, nil
 }
 
 // Creates a node for each instance of a component and adds it to the graph.
@@ -284,6 +447,11 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 	for i := len(nodes) - 1; i >= 0; i-- {
 		node := nodes[i]
 
+		if prev, ok := g.reused[node.ID()]; ok {
+			reuseNode(node, prev)
+			continue
+		}
+
 		switch n := node.(type) {
 		case *receiverNode:
 			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ReceiverBuilder, g.nextConsumers(n.ID()))
@@ -357,12 +525,38 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 	return nil
 }
 
-// Find all nodes
+// reuseNode copies the component and the consumer built for prev, an identical node of a previous graph, to node.
+func reuseNode(node, prev graph.Node) {
+	switch n := node.(type) {
+	case *receiverNode:
+		*n = *prev.(*receiverNode)
+	case *processorNode:
+		*n = *prev.(*processorNode)
+	case *exporterNode:
+		*n = *prev.(*exporterNode)
+	case *connectorNode:
+		*n = *prev.(*connectorNode)
+	case *capabilitiesNode:
+		*n = *prev.(*capabilitiesNode)
+	case *fanOutNode:
+		*n = *prev.(*fanOutNode)
+	}
+}
+
+// nextConsumers returns the consumers of the nodes following the given node. When the taps are enabled, they are
+// wrapped in edge taps, except the ones following a capabilities node, which is internal to a pipeline.
 func (g *Graph) nextConsumers(nodeID int64) []baseConsumer {
 	nextNodes := g.componentGraph.From(nodeID)
 	nexts := make([]baseConsumer, 0, nextNodes.Len())
+	_, isCapabilities := g.componentGraph.Node(nodeID).(*capabilitiesNode)
 	for nextNodes.Next() {
-		nexts = append(nexts, nextNodes.Node().(consumerNode).getConsumer())
+		next := nextNodes.Node().(consumerNode).getConsumer()
+		if g.settings.EnableTaps && !isCapabilities {
+			et := newEdgeTap(edgeKey{from: nodeID, to: nextNodes.Node().ID()}, next)
+			g.edgeTaps[et.key] = et
+			next = et
+		}
+		nexts = append(nexts, next)
 	}
 	return nexts
 }
@@ -408,6 +602,11 @@ func (g *Graph) StartAll(ctx context.Context, host *Host) error {
 			continue
 		}
 
+		if _, reused := g.reused[node.ID()]; reused {
+			// Skip the components reused from the previous graph, they are already running
+			continue
+		}
+
 		instanceID := g.instanceIDs[node.ID()]
 		host.Reporter.ReportStatus(
 			instanceID,
@@ -435,6 +634,17 @@ func (g *Graph) StartAll(ctx context.Context, host *Host) error {
 }
 
 func (g *Graph) ShutdownAll(ctx context.Context, reporter status.Reporter) error {
+	return g.shutdown(ctx, reporter, nil)
+}
+
+// ShutdownReplaced stops the components of g that are not reused by next, the graph rebuilt from g.
+// The reused components keep running, and are stopped by next from then on.
+func (g *Graph) ShutdownReplaced(ctx context.Context, reporter status.Reporter, next *Graph) error {
+	return g.shutdown(ctx, reporter, next.reused)
+}
+
+// shutdown stops the components of g, except the ones of the kept nodes.
+func (g *Graph) shutdown(ctx context.Context, reporter status.Reporter, kept map[int64]graph.Node) error {
 	nodes, err := topo.Sort(g.componentGraph)
 	if err != nil {
 		return err
@@ -454,6 +664,10 @@ func (g *Graph) ShutdownAll(ctx context.Context, reporter status.Reporter) error
 			continue
 		}
 
+		if _, ok = kept[node.ID()]; ok {
+			continue
+		}
+
 		instanceID := g.instanceIDs[node.ID()]
 		reporter.ReportStatus(
 			instanceID,
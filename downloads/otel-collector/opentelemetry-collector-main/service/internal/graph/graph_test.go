@@ -1464,6 +1464,185 @@ func TestConnectorRouter(t *testing.T) {
 
 }
 
+func TestGraphRebuild(t *testing.T) {
+	tracesID := component.MustNewID("traces")
+	metricsID := component.MustNewID("metrics")
+	logsID := component.MustNewID("logs")
+	rcvrID := component.MustNewID("examplereceiver")
+	rcvr1ID := component.MustNewIDWithName("examplereceiver", "1")
+	procID := component.MustNewID("exampleprocessor")
+	expID := component.MustNewID("exampleexporter")
+	exp1ID := component.MustNewIDWithName("exampleexporter", "1")
+
+	newSettings := func(exp1Endpoint string, pipelineCfgs pipelines.Config) Settings {
+		// The configs are not empty structs, so every call creates distinct receivers.
+		return Settings{
+			Telemetry: componenttest.NewNopTelemetrySettings(),
+			BuildInfo: component.NewDefaultBuildInfo(),
+			ReceiverBuilder: builders.NewReceiver(
+				map[component.ID]component.Config{
+					rcvrID:  &struct{ Name string }{Name: "0"},
+					rcvr1ID: &struct{ Name string }{Name: "1"},
+				},
+				map[component.Type]receiver.Factory{testcomponents.ExampleReceiverFactory.Type(): testcomponents.ExampleReceiverFactory},
+			),
+			ProcessorBuilder: builders.NewProcessor(
+				map[component.ID]component.Config{procID: testcomponents.ExampleProcessorFactory.CreateDefaultConfig()},
+				map[component.Type]processor.Factory{testcomponents.ExampleProcessorFactory.Type(): testcomponents.ExampleProcessorFactory},
+			),
+			ExporterBuilder: builders.NewExporter(
+				map[component.ID]component.Config{
+					expID:  testcomponents.ExampleExporterFactory.CreateDefaultConfig(),
+					exp1ID: &struct{ Endpoint string }{Endpoint: exp1Endpoint},
+				},
+				map[component.Type]exporter.Factory{testcomponents.ExampleExporterFactory.Type(): testcomponents.ExampleExporterFactory},
+			),
+			ConnectorBuilder: builders.NewConnector(map[component.ID]component.Config{}, map[component.Type]connector.Factory{}),
+			PipelineConfigs:  pipelineCfgs,
+			EnableTaps:       true,
+		}
+	}
+	pipelineCfgs := pipelines.Config{
+		tracesID: {
+			Receivers:  []component.ID{rcvrID},
+			Processors: []component.ID{procID},
+			Exporters:  []component.ID{expID, exp1ID},
+		},
+		// The receiver is shared with the traces pipeline.
+		logsID: {
+			Receivers: []component.ID{rcvrID},
+			Exporters: []component.ID{expID},
+		},
+		metricsID: {
+			Receivers: []component.ID{rcvr1ID},
+			Exporters: []component.ID{expID},
+		},
+	}
+	host := &Host{Reporter: status.NewReporter(func(*componentstatus.InstanceID, *componentstatus.Event) {}, func(error) {})}
+
+	pg, err := Build(context.Background(), newSettings("old", pipelineCfgs))
+	require.NoError(t, err)
+	require.NoError(t, pg.StartAll(context.Background(), host))
+	oldReceivers := pg.getReceivers()
+	oldExporters := pg.GetExporters()
+	oldProc := pg.pipelines[tracesID].processors[0].Component
+
+	t.Run("unchanged", func(t *testing.T) {
+		next, err := pg.Rebuild(context.Background(), newSettings("old", pipelineCfgs))
+		require.NoError(t, err)
+		require.NoError(t, pg.ShutdownReplaced(context.Background(), statustest.NewNopStatusReporter(), next))
+		require.NoError(t, next.StartAll(context.Background(), host))
+
+		assert.Equal(t, oldReceivers, next.getReceivers())
+		assert.Equal(t, oldExporters, next.GetExporters())
+		assert.Same(t, oldProc, next.pipelines[tracesID].processors[0].Component)
+		assert.False(t, oldReceivers[component.DataTypeTraces][rcvrID].(*testcomponents.ExampleReceiver).Stopped())
+		// The reused components keep sending data through the same edge taps.
+		assert.Equal(t, pg.edgeTaps, next.edgeTaps)
+		pg = next
+	})
+
+	t.Run("changed exporter", func(t *testing.T) {
+		next, err := pg.Rebuild(context.Background(), newSettings("new", pipelineCfgs))
+		require.NoError(t, err)
+		require.NoError(t, pg.ShutdownReplaced(context.Background(), statustest.NewNopStatusReporter(), next))
+		require.NoError(t, next.StartAll(context.Background(), host))
+
+		// The changed exporter and the components upstream of it are replaced.
+		newExporters := next.GetExporters()
+		assert.NotSame(t, oldExporters[component.DataTypeTraces][exp1ID], newExporters[component.DataTypeTraces][exp1ID])
+		assert.True(t, oldExporters[component.DataTypeTraces][exp1ID].(*testcomponents.ExampleExporter).Stopped())
+		assert.True(t, newExporters[component.DataTypeTraces][exp1ID].(*testcomponents.ExampleExporter).Started())
+		assert.NotSame(t, oldProc, next.pipelines[tracesID].processors[0].Component)
+		assert.True(t, oldProc.(*testcomponents.ExampleProcessor).Stopped())
+
+		// The receiver shared by the traces and logs pipelines is replaced in both.
+		newReceivers := next.getReceivers()
+		oldRcvr := oldReceivers[component.DataTypeTraces][rcvrID].(*testcomponents.ExampleReceiver)
+		newRcvr := newReceivers[component.DataTypeTraces][rcvrID].(*testcomponents.ExampleReceiver)
+		assert.NotSame(t, oldRcvr, newRcvr)
+		assert.Same(t, newRcvr, newReceivers[component.DataTypeLogs][rcvrID])
+		assert.True(t, oldRcvr.Stopped())
+		assert.True(t, newRcvr.Started())
+
+		// The unaffected components keep running.
+		for _, dt := range []component.DataType{component.DataTypeTraces, component.DataTypeMetrics, component.DataTypeLogs} {
+			assert.Same(t, oldExporters[dt][expID], newExporters[dt][expID])
+			assert.False(t, oldExporters[dt][expID].(*testcomponents.ExampleExporter).Stopped())
+		}
+		assert.Same(t, oldReceivers[component.DataTypeMetrics][rcvr1ID], newReceivers[component.DataTypeMetrics][rcvr1ID])
+		assert.False(t, oldReceivers[component.DataTypeMetrics][rcvr1ID].(*testcomponents.ExampleReceiver).Stopped())
+
+		// The data flows from the new receiver to both the new and the reused exporters.
+		require.NoError(t, newRcvr.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+		assert.Len(t, newExporters[component.DataTypeTraces][expID].(*testcomponents.ExampleExporter).Traces, 1)
+		assert.Len(t, newExporters[component.DataTypeTraces][exp1ID].(*testcomponents.ExampleExporter).Traces, 1)
+		pg = next
+	})
+
+	t.Run("removed pipeline", func(t *testing.T) {
+		exporters := pg.GetExporters()
+		next, err := pg.Rebuild(context.Background(), newSettings("new", pipelines.Config{
+			tracesID:  pipelineCfgs[tracesID],
+			metricsID: pipelineCfgs[metricsID],
+		}))
+		require.NoError(t, err)
+		require.NoError(t, pg.ShutdownReplaced(context.Background(), statustest.NewNopStatusReporter(), next))
+		require.NoError(t, next.StartAll(context.Background(), host))
+
+		assert.True(t, exporters[component.DataTypeLogs][expID].(*testcomponents.ExampleExporter).Stopped())
+		assert.Same(t, exporters[component.DataTypeTraces][exp1ID], next.GetExporters()[component.DataTypeTraces][exp1ID])
+		assert.False(t, exporters[component.DataTypeTraces][exp1ID].(*testcomponents.ExampleExporter).Stopped())
+		pg = next
+	})
+
+	require.NoError(t, pg.ShutdownAll(context.Background(), statustest.NewNopStatusReporter()))
+	for _, exp := range pg.GetExporters()[component.DataTypeTraces] {
+		assert.True(t, exp.(*testcomponents.ExampleExporter).Stopped())
+	}
+}
+
+func TestGraphRebuildErrors(t *testing.T) {
+	nopReceiverFactory := receivertest.NewNopFactory()
+	nopExporterFactory := exportertest.NewNopFactory()
+	errExporterFactory := newBadExporterFactory()
+	set := Settings{
+		Telemetry: componenttest.NewNopTelemetrySettings(),
+		BuildInfo: component.NewDefaultBuildInfo(),
+		ReceiverBuilder: builders.NewReceiver(
+			map[component.ID]component.Config{component.NewID(nopReceiverFactory.Type()): nopReceiverFactory.CreateDefaultConfig()},
+			map[component.Type]receiver.Factory{nopReceiverFactory.Type(): nopReceiverFactory}),
+		ProcessorBuilder: builders.NewProcessor(map[component.ID]component.Config{}, map[component.Type]processor.Factory{}),
+		ExporterBuilder: builders.NewExporter(
+			map[component.ID]component.Config{
+				component.NewID(nopExporterFactory.Type()): nopExporterFactory.CreateDefaultConfig(),
+				component.NewID(errExporterFactory.Type()): errExporterFactory.CreateDefaultConfig(),
+			},
+			map[component.Type]exporter.Factory{
+				nopExporterFactory.Type(): nopExporterFactory,
+				errExporterFactory.Type(): errExporterFactory,
+			}),
+		ConnectorBuilder: builders.NewConnector(map[component.ID]component.Config{}, map[component.Type]connector.Factory{}),
+		PipelineConfigs: pipelines.Config{
+			component.MustNewID("traces"): {
+				Receivers: []component.ID{component.MustNewID("nop")},
+				Exporters: []component.ID{component.MustNewID("nop")},
+			},
+		},
+	}
+	pg, err := Build(context.Background(), set)
+	require.NoError(t, err)
+
+	set.PipelineConfigs = pipelines.Config{
+		component.MustNewID("traces"): {
+			Receivers: []component.ID{component.MustNewID("nop")},
+			Exporters: []component.ID{component.MustNewID("nop"), component.MustNewID("bf")},
+		},
+	}
+	_, err = pg.Rebuild(context.Background(), set)
+	require.Error(t, err)
+}
+
 func TestGraphBuildErrors(t *testing.T) {
 	nopReceiverFactory := receivertest.NewNopFactory()
 	nopProcessorFactory := processortest.NewNopFactory()
@@ -4,17 +4,23 @@
 package graph // import "go.opentelemetry.io/collector/service/internal/graph"
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"path"
 	"runtime"
+	"sync"
 	"time"
 
+	"go.uber.org/zap/zapcore"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/internal/builders"
+	"go.opentelemetry.io/collector/service/internal/loglevels"
 	"go.opentelemetry.io/collector/service/internal/status"
 	"go.opentelemetry.io/collector/service/internal/zpages"
 )
@@ -29,22 +35,52 @@ var _ component.Host = (*Host)(nil)
 
 type Host struct {
 	AsyncErrorChannel chan error
-	Receivers         *builders.ReceiverBuilder
-	Processors        *builders.ProcessorBuilder
-	Exporters         *builders.ExporterBuilder
-	Connectors        *builders.ConnectorBuilder
 	Extensions        *builders.ExtensionBuilder
 
+	// mu guards the builders, the module info and the pipelines below, which are replaced when the pipelines
+	// are reloaded while the extensions and the zPages use them.
+	mu         sync.RWMutex
+	Receivers  *builders.ReceiverBuilder
+	Processors *builders.ProcessorBuilder
+	Exporters  *builders.ExporterBuilder
+	Connectors *builders.ConnectorBuilder
 	ModuleInfo extension.ModuleInfo
-	BuildInfo  component.BuildInfo
+	Pipelines  *Graph
 
-	Pipelines         *Graph
+	BuildInfo         component.BuildInfo
 	ServiceExtensions *extensions.Extensions
 
 	Reporter status.Reporter
+
+	// LogLevels holds the logging levels of the collector and of its components, nil if they can't be changed.
+	LogLevels *loglevels.Registry
+	// ReloadChannel is the channel used to request the collector to reload its configuration.
+	ReloadChannel chan struct{}
+}
+
+// ReplacePipelines replaces the builders and the pipelines once the pipelines are reloaded.
+func (host *Host) ReplacePipelines(receivers *builders.ReceiverBuilder, processors *builders.ProcessorBuilder,
+	exporters *builders.ExporterBuilder, connectors *builders.ConnectorBuilder, pipelines *Graph, moduleInfo extension.ModuleInfo) {
+	host.mu.Lock()
+	defer host.mu.Unlock()
+	host.Receivers = receivers
+	host.Processors = processors
+	host.Exporters = exporters
+	host.Connectors = connectors
+	host.Pipelines = pipelines
+	host.ModuleInfo = moduleInfo
+}
+
+// pipelines returns the current pipelines, which are replaced when the pipelines are reloaded.
+func (host *Host) pipelines() *Graph {
+	host.mu.RLock()
+	defer host.mu.RUnlock()
+	return host.Pipelines
 }
 
 func (host *Host) GetFactory(kind component.Kind, componentType component.Type) component.Factory {
+	host.mu.RLock()
+	defer host.mu.RUnlock()
 	switch kind {
 	case component.KindReceiver:
 		return host.Receivers.Factory(componentType)
@@ -71,7 +107,7 @@ func (host *Host) GetExtensions() map[component.ID]component.Component {
 // https://github.com/open-telemetry/opentelemetry-collector/pull/7390#issuecomment-1483710184
 // for additional information.
 func (host *Host) GetExporters() map[component.DataType]map[component.ID]component.Component {
-	return host.Pipelines.GetExporters()
+	return host.pipelines().GetExporters()
 }
 
 func (host *Host) NotifyComponentStatusChange(source *componentstatus.InstanceID, event *componentstatus.Event) {
@@ -81,12 +117,97 @@ func (host *Host) NotifyComponentStatusChange(source *componentstatus.InstanceID
 	}
 }
 
+var (
+	errLogLevelsUnavailable = errors.New("the logging levels can't be changed at runtime")
+	errReloadUnavailable    = errors.New("the configuration reload can't be requested")
+)
+
+// GetLogLevels returns the logging level of the collector, and the levels set at runtime for its components
+// by component key, like "exporter/otlp/2".
+func (host *Host) GetLogLevels() (zapcore.Level, map[string]zapcore.Level, error) {
+	if host.LogLevels == nil {
+		return zapcore.InvalidLevel, nil, errLogLevelsUnavailable
+	}
+	return host.LogLevels.Level(), host.LogLevels.ComponentLevels(), nil
+}
+
+// SetLogLevel sets the logging level of the configured component with the given key, like "exporter/otlp/2",
+// or of the collector if the key is empty. The components without a level of their own log at the level of the collector.
+func (host *Host) SetLogLevel(key string, level zapcore.Level) error {
+	if host.LogLevels == nil {
+		return errLogLevelsUnavailable
+	}
+	if key == "" {
+		host.LogLevels.SetLevel(level)
+		return nil
+	}
+	if err := host.validateComponentKey(key); err != nil {
+		return err
+	}
+	host.LogLevels.SetComponentLevel(key, level)
+	return nil
+}
+
+// ResetLogLevel makes the component with the given key log at its configured level again, or else at the level of the collector.
+func (host *Host) ResetLogLevel(key string) error {
+	if host.LogLevels == nil {
+		return errLogLevelsUnavailable
+	}
+	if _, _, err := loglevels.ParseKey(key); err != nil {
+		return err
+	}
+	host.LogLevels.ResetComponentLevel(key)
+	return nil
+}
+
+// validateComponentKey returns an error if the key isn't the key of a configured component.
+func (host *Host) validateComponentKey(key string) error {
+	kind, id, err := loglevels.ParseKey(key)
+	if err != nil {
+		return err
+	}
+	var configured bool
+	host.mu.RLock()
+	defer host.mu.RUnlock()
+	switch kind {
+	case component.KindReceiver:
+		configured = host.Receivers.Config(id) != nil
+	case component.KindProcessor:
+		configured = host.Processors.Config(id) != nil
+	case component.KindExporter:
+		configured = host.Exporters.Config(id) != nil
+	case component.KindConnector:
+		configured = host.Connectors.Config(id) != nil
+	case component.KindExtension:
+		_, configured = host.ServiceExtensions.GetExtensions()[id]
+	}
+	if !configured {
+		return fmt.Errorf("component %q is not configured", key)
+	}
+	return nil
+}
+
+// RequestReload requests the collector to reload its configuration, as it does when it receives a SIGHUP.
+// The request is ignored if a reload is already pending.
+func (host *Host) RequestReload() error {
+	if host.ReloadChannel == nil {
+		return errReloadUnavailable
+	}
+	select {
+	case host.ReloadChannel <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
 const (
 	// Paths
 	zServicePath   = "servicez"
 	zPipelinePath  = "pipelinez"
 	zExtensionPath = "extensionz"
 	zFeaturePath   = "featurez"
+	zTopologyPath  = "topologyz"
+	zTapPath       = "tapz"
 )
 
 var (
@@ -106,9 +227,18 @@ func init() {
 
 func (host *Host) RegisterZPages(mux *http.ServeMux, pathPrefix string) {
 	mux.HandleFunc(path.Join(pathPrefix, zServicePath), host.zPagesRequest)
-	mux.HandleFunc(path.Join(pathPrefix, zPipelinePath), host.Pipelines.HandleZPages)
+	mux.HandleFunc(path.Join(pathPrefix, zPipelinePath), host.pipelinezRequest)
 	mux.HandleFunc(path.Join(pathPrefix, zExtensionPath), host.ServiceExtensions.HandleZPages)
 	mux.HandleFunc(path.Join(pathPrefix, zFeaturePath), handleFeaturezRequest)
+	mux.HandleFunc(path.Join(pathPrefix, zTopologyPath), host.topologyzRequest)
+}
+
+// RegisterTapZPage registers the tapz zPage, streaming up to maxItems batches of the data sent from a node of the
+// pipelines to another. It is not registered by RegisterZPages since it exposes the data flowing through the pipelines.
+func (host *Host) RegisterTapZPage(mux *http.ServeMux, pathPrefix string, maxItems int) {
+	mux.HandleFunc(path.Join(pathPrefix, zTapPath), func(w http.ResponseWriter, r *http.Request) {
+		host.pipelines().handleTapZPages(w, r, maxItems)
+	})
 }
 
 func (host *Host) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
@@ -131,9 +261,22 @@ func (host *Host) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
 		ComponentEndpoint: zFeaturePath,
 		Link:              true,
 	})
+	zpages.WriteHTMLComponentHeader(w, zpages.ComponentHeaderData{
+		Name:              "Topology",
+		ComponentEndpoint: zTopologyPath,
+		Link:              true,
+	})
 	zpages.WriteHTMLPageFooter(w)
 }
 
+func (host *Host) pipelinezRequest(w http.ResponseWriter, r *http.Request) {
+	host.pipelines().HandleZPages(w, r)
+}
+
+func (host *Host) topologyzRequest(w http.ResponseWriter, r *http.Request) {
+	host.pipelines().handleTopologyZPages(w, r, host.Reporter)
+}
+
 func handleFeaturezRequest(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	zpages.WriteHTMLPageHeader(w, zpages.HeaderData{Title: "Feature Gates"})
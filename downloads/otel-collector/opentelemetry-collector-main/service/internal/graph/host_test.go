@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/service/extensions"
+	"go.opentelemetry.io/collector/service/internal/builders"
+	"go.opentelemetry.io/collector/service/internal/loglevels"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func TestHostLogLevels(t *testing.T) {
+	host := &Host{
+		Receivers:  builders.NewReceiver(builders.NewNopReceiverConfigsAndFactories()),
+		Processors: builders.NewProcessor(builders.NewNopProcessorConfigsAndFactories()),
+		Exporters:  builders.NewExporter(builders.NewNopExporterConfigsAndFactories()),
+		Connectors: builders.NewConnector(builders.NewNopConnectorConfigsAndFactories()),
+		Extensions: builders.NewExtension(builders.NewNopExtensionConfigsAndFactories()),
+	}
+	_, _, err := host.GetLogLevels()
+	require.ErrorIs(t, err, errLogLevelsUnavailable)
+	require.ErrorIs(t, host.SetLogLevel("", zapcore.DebugLevel), errLogLevelsUnavailable)
+	require.ErrorIs(t, host.ResetLogLevel("receiver/nop"), errLogLevelsUnavailable)
+
+	host.ServiceExtensions, err = extensions.New(context.Background(), extensions.Settings{
+		Telemetry:  componenttest.NewNopTelemetrySettings(),
+		BuildInfo:  component.NewDefaultBuildInfo(),
+		Extensions: host.Extensions,
+	}, extensions.Config{component.MustNewID("nop")})
+	require.NoError(t, err)
+	host.LogLevels, err = loglevels.NewRegistry(zapcore.InfoLevel, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, host.SetLogLevel("", zapcore.WarnLevel))
+	for _, key := range []string{"receiver/nop", "processor/nop", "exporter/nop", "connector/nop/conn", "extension/nop"} {
+		require.NoError(t, host.SetLogLevel(key, zapcore.DebugLevel))
+	}
+	require.EqualError(t, host.SetLogLevel("receiver/otlp", zapcore.DebugLevel), `component "receiver/otlp" is not configured`)
+	require.EqualError(t, host.SetLogLevel("otlp", zapcore.DebugLevel), `invalid component "otlp", must be <kind>/<id>`)
+	require.NoError(t, host.ResetLogLevel("exporter/nop"))
+	require.Error(t, host.ResetLogLevel("otlp"))
+
+	level, levels, err := host.GetLogLevels()
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.WarnLevel, level)
+	assert.Equal(t, map[string]zapcore.Level{
+		"receiver/nop":       zapcore.DebugLevel,
+		"processor/nop":      zapcore.DebugLevel,
+		"connector/nop/conn": zapcore.DebugLevel,
+		"extension/nop":      zapcore.DebugLevel,
+	}, levels)
+}
+
+func TestHostRequestReload(t *testing.T) {
+	host := &Host{}
+	require.ErrorIs(t, host.RequestReload(), errReloadUnavailable)
+
+	host.ReloadChannel = make(chan struct{}, 1)
+	require.NoError(t, host.RequestReload())
+	// The second request is ignored, since a reload is already pending.
+	require.NoError(t, host.RequestReload())
+	assert.Len(t, host.ReloadChannel, 1)
+}
+
+func TestHostReplacePipelines(t *testing.T) {
+	host := newTopologyHost(t, false)
+	mux := http.NewServeMux()
+	host.RegisterZPages(mux, "/debug")
+
+	receivers := builders.NewReceiver(builders.NewNopReceiverConfigsAndFactories())
+	processors := builders.NewProcessor(builders.NewNopProcessorConfigsAndFactories())
+	exporters := builders.NewExporter(builders.NewNopExporterConfigsAndFactories())
+	connectors := builders.NewConnector(builders.NewNopConnectorConfigsAndFactories())
+	pg, err := Build(context.Background(), Settings{
+		Telemetry:        componenttest.NewNopTelemetrySettings(),
+		BuildInfo:        component.NewDefaultBuildInfo(),
+		ReceiverBuilder:  receivers,
+		ProcessorBuilder: processors,
+		ExporterBuilder:  exporters,
+		ConnectorBuilder: connectors,
+		PipelineConfigs: pipelines.Config{
+			component.MustNewID("metrics"): {
+				Receivers: []component.ID{component.MustNewID("nop")},
+				Exporters: []component.ID{component.MustNewID("nop")},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The extensions and the zPages can use the host while the pipelines are replaced.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = host.GetExporters()
+			_ = host.GetFactory(component.KindReceiver, component.MustNewType("nop"))
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/debug/pipelinez", nil))
+		}
+	}()
+	host.ReplacePipelines(receivers, processors, exporters, connectors, pg, host.ModuleInfo)
+	wg.Wait()
+
+	assert.Same(t, pg, host.Pipelines)
+	assert.Empty(t, host.GetExporters()[component.DataTypeTraces])
+	assert.Len(t, host.GetExporters()[component.DataTypeMetrics], 1)
+
+	// The zPages registered before the reload return the new pipelines.
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/topologyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var topo topology
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &topo))
+	assert.Equal(t, pg.topology(host.Reporter), &topo)
+}
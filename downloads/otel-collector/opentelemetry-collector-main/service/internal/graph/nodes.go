@@ -255,7 +255,7 @@ func (n *connectorNode) buildComponent(
 		capability := consumer.Capabilities{MutatesData: false}
 		consumers := make(map[component.ID]consumer.Traces, len(nexts))
 		for _, next := range nexts {
-			consumers[next.(*capabilitiesNode).pipelineID] = next.(consumer.Traces)
+			consumers[nextPipelineID(next)] = next.(consumer.Traces)
 			capability.MutatesData = capability.MutatesData || next.Capabilities().MutatesData
 		}
 		next := connector.NewTracesRouter(consumers)
@@ -297,7 +297,7 @@ func (n *connectorNode) buildComponent(
 		capability := consumer.Capabilities{MutatesData: false}
 		consumers := make(map[component.ID]consumer.Metrics, len(nexts))
 		for _, next := range nexts {
-			consumers[next.(*capabilitiesNode).pipelineID] = next.(consumer.Metrics)
+			consumers[nextPipelineID(next)] = next.(consumer.Metrics)
 			capability.MutatesData = capability.MutatesData || next.Capabilities().MutatesData
 		}
 		next := connector.NewMetricsRouter(consumers)
@@ -338,7 +338,7 @@ func (n *connectorNode) buildComponent(
 		capability := consumer.Capabilities{MutatesData: false}
 		consumers := make(map[component.ID]consumer.Logs, len(nexts))
 		for _, next := range nexts {
-			consumers[next.(*capabilitiesNode).pipelineID] = next.(consumer.Logs)
+			consumers[nextPipelineID(next)] = next.(consumer.Logs)
 			capability.MutatesData = capability.MutatesData || next.Capabilities().MutatesData
 		}
 		next := connector.NewLogsRouter(consumers)
@@ -379,7 +379,7 @@ func (n *connectorNode) buildComponent(
 		capability := consumer.Capabilities{MutatesData: false}
 		consumers := make(map[component.ID]consumerprofiles.Profiles, len(nexts))
 		for _, next := range nexts {
-			consumers[next.(*capabilitiesNode).pipelineID] = next.(consumerprofiles.Profiles)
+			consumers[nextPipelineID(next)] = next.(consumerprofiles.Profiles)
 			capability.MutatesData = capability.MutatesData || next.Capabilities().MutatesData
 		}
 		next := connectorprofiles.NewProfilesRouter(consumers)
@@ -420,6 +420,15 @@ func (n *connectorNode) buildComponent(
 	return nil
 }
 
+// nextPipelineID returns the ID of the pipeline a connector emits to through the given consumer,
+// which is wrapped in an edge tap when the taps are enabled.
+func nextPipelineID(next baseConsumer) component.ID {
+	if et, ok := next.(*edgeTap); ok {
+		next = et.next
+	}
+	return next.(*capabilitiesNode).pipelineID
+}
+
 var _ consumerNode = (*capabilitiesNode)(nil)
 
 // Every pipeline has a "virtual" capabilities node immediately after the receiver(s).
@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph // import "go.opentelemetry.io/collector/service/internal/graph"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
+	"go.opentelemetry.io/collector/internal/otlptext"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// URL Params
+	zTapFrom   = "from"
+	zTapTo     = "to"
+	zTapFormat = "format"
+	zTapItems  = "items"
+
+	tapFormatText = "text"
+	tapFormatJSON = "json"
+
+	defaultTapItems = 10
+)
+
+// edgeKey identifies an edge of the graph by the IDs of its nodes.
+type edgeKey struct {
+	from, to int64
+}
+
+// edgeTap is the consumer given to a node to send data to the next node. It copies the data to the taps attached
+// to the edge, if any: when no tap is attached, the data is forwarded after a single atomic load.
+type edgeTap struct {
+	key  edgeKey
+	next baseConsumer
+
+	traces   consumer.Traces
+	metrics  consumer.Metrics
+	logs     consumer.Logs
+	profiles consumerprofiles.Profiles
+
+	mu   sync.Mutex
+	taps atomic.Pointer[[]*tap]
+}
+
+func newEdgeTap(key edgeKey, next baseConsumer) *edgeTap {
+	et := &edgeTap{key: key, next: next}
+	et.traces, _ = next.(consumer.Traces)
+	et.metrics, _ = next.(consumer.Metrics)
+	et.logs, _ = next.(consumer.Logs)
+	et.profiles, _ = next.(consumerprofiles.Profiles)
+	return et
+}
+
+func (et *edgeTap) Capabilities() consumer.Capabilities {
+	return et.next.Capabilities()
+}
+
+func (et *edgeTap) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if taps := et.taps.Load(); taps != nil {
+		for _, t := range *taps {
+			t.capture(func(f string) ([]byte, error) { return tracesMarshaler(f).MarshalTraces(td) })
+		}
+	}
+	return et.traces.ConsumeTraces(ctx, td)
+}
+
+func (et *edgeTap) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if taps := et.taps.Load(); taps != nil {
+		for _, t := range *taps {
+			t.capture(func(f string) ([]byte, error) { return metricsMarshaler(f).MarshalMetrics(md) })
+		}
+	}
+	return et.metrics.ConsumeMetrics(ctx, md)
+}
+
+func (et *edgeTap) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if taps := et.taps.Load(); taps != nil {
+		for _, t := range *taps {
+			t.capture(func(f string) ([]byte, error) { return logsMarshaler(f).MarshalLogs(ld) })
+		}
+	}
+	return et.logs.ConsumeLogs(ctx, ld)
+}
+
+func (et *edgeTap) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	if taps := et.taps.Load(); taps != nil {
+		for _, t := range *taps {
+			t.capture(func(f string) ([]byte, error) { return profilesMarshaler(f).MarshalProfiles(pd) })
+		}
+	}
+	return et.profiles.ConsumeProfiles(ctx, pd)
+}
+
+// attach starts copying the data sent through the edge to the tap.
+func (et *edgeTap) attach(t *tap) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	var taps []*tap
+	if current := et.taps.Load(); current != nil {
+		taps = slices.Clone(*current)
+	}
+	taps = append(taps, t)
+	et.taps.Store(&taps)
+}
+
+// detach stops copying the data sent through the edge to the tap.
+func (et *edgeTap) detach(t *tap) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+	current := et.taps.Load()
+	if current == nil {
+		return
+	}
+	taps := slices.DeleteFunc(slices.Clone(*current), func(other *tap) bool { return other == t })
+	if len(taps) == 0 {
+		et.taps.Store(nil)
+		return
+	}
+	et.taps.Store(&taps)
+}
+
+// tap captures up to a number of batches of data sent through an edge, rendered in the requested format.
+type tap struct {
+	format    string
+	remaining atomic.Int64
+	// items is buffered for all the batches to capture, so the components never wait for the client.
+	items chan []byte
+}
+
+func newTap(format string, items int) *tap {
+	t := &tap{format: format, items: make(chan []byte, items)}
+	t.remaining.Store(int64(items))
+	return t
+}
+
+// capture renders a batch of data with marshal, unless the tap already captured all its batches.
+// The data is rendered before it is sent to the next node, which may modify it.
+func (t *tap) capture(marshal func(format string) ([]byte, error)) {
+	if t.remaining.Add(-1) < 0 {
+		return
+	}
+	buf, err := marshal(t.format)
+	if err != nil {
+		buf = []byte(fmt.Sprintf("failed to render the data: %v", err))
+	}
+	t.items <- buf
+}
+
+func tracesMarshaler(format string) ptrace.Marshaler {
+	if format == tapFormatJSON {
+		return &ptrace.JSONMarshaler{}
+	}
+	return otlptext.NewTextTracesMarshaler()
+}
+
+func metricsMarshaler(format string) pmetric.Marshaler {
+	if format == tapFormatJSON {
+		return &pmetric.JSONMarshaler{}
+	}
+	return otlptext.NewTextMetricsMarshaler()
+}
+
+func logsMarshaler(format string) plog.Marshaler {
+	if format == tapFormatJSON {
+		return &plog.JSONMarshaler{}
+	}
+	return otlptext.NewTextLogsMarshaler()
+}
+
+func profilesMarshaler(format string) pprofile.Marshaler {
+	if format == tapFormatJSON {
+		return &pprofile.JSONMarshaler{}
+	}
+	return otlptext.NewTextProfilesMarshaler()
+}
+
+// edgeTapFor returns the edge tap of the edge between the nodes with the given topology IDs.
+// The edges from the receivers and the connectors go through the capabilities node of their pipeline,
+// which is left out of the topology: they are found by the node following the capabilities node.
+func (g *Graph) edgeTapFor(from, to string) (*edgeTap, bool) {
+	for key, et := range g.edgeTaps {
+		if id, ok := topologyNodeID(g.componentGraph.Node(key.from)); !ok || id != from {
+			continue
+		}
+		toNode := g.componentGraph.Node(key.to)
+		if _, isCapabilities := toNode.(*capabilitiesNode); isCapabilities {
+			succ := g.componentGraph.From(key.to)
+			if !succ.Next() {
+				continue
+			}
+			toNode = succ.Node()
+		}
+		if id, ok := topologyNodeID(toNode); ok && id == to {
+			return et, true
+		}
+	}
+	return nil, false
+}
+
+// handleTapZPages attaches a tap to the edge between the nodes given by the "from" and "to" URL params, as listed
+// by the topologyz zPage, and streams the captured batches of data until the requested number of batches is captured
+// or the client disconnects. The tap is detached once the request ends.
+func (g *Graph) handleTapZPages(w http.ResponseWriter, r *http.Request, maxItems int) {
+	qValues := r.URL.Query()
+	from, to := qValues.Get(zTapFrom), qValues.Get(zTapTo)
+	if from == "" || to == "" {
+		http.Error(w, fmt.Sprintf("the %q and %q params are required, the edges are listed by %s", zTapFrom, zTapTo, zTopologyPath), http.StatusBadRequest)
+		return
+	}
+
+	format := qValues.Get(zTapFormat)
+	switch format {
+	case "":
+		format = tapFormatText
+	case tapFormatText, tapFormatJSON:
+	default:
+		http.Error(w, fmt.Sprintf("invalid format %q, must be %q or %q", format, tapFormatText, tapFormatJSON), http.StatusBadRequest)
+		return
+	}
+
+	items := min(defaultTapItems, maxItems)
+	if s := qValues.Get(zTapItems); s != "" {
+		var err error
+		if items, err = strconv.Atoi(s); err != nil || items < 1 || items > maxItems {
+			http.Error(w, fmt.Sprintf("invalid items %q, must be between 1 and %d", s, maxItems), http.StatusBadRequest)
+			return
+		}
+	}
+
+	et, ok := g.edgeTapFor(from, to)
+	if !ok {
+		http.Error(w, fmt.Sprintf("edge from %q to %q not found", from, to), http.StatusNotFound)
+		return
+	}
+
+	t := newTap(format, items)
+	et.attach(t)
+	defer et.detach(t)
+
+	if format == tapFormatJSON {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	}
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	for i := 0; i < items; i++ {
+		select {
+		case <-r.Context().Done():
+			return
+		case buf := <-t.items:
+			if _, err := w.Write(append(buf, '\n')); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
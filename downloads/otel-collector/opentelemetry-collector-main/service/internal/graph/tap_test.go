@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestEdgeTap(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	et := newEdgeTap(edgeKey{from: 1, to: 2}, sink)
+	assert.Equal(t, sink.Capabilities(), et.Capabilities())
+
+	require.NoError(t, et.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.Nil(t, et.taps.Load())
+
+	t1 := newTap(tapFormatJSON, 2)
+	t2 := newTap(tapFormatText, 1)
+	et.attach(t1)
+	et.attach(t2)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, et.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	}
+	assert.Len(t, sink.AllTraces(), 4)
+	assert.Len(t, t1.items, 2)
+	assert.Len(t, t2.items, 1)
+
+	td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(<-t1.items)
+	require.NoError(t, err)
+	assert.Equal(t, testdata.GenerateTraces(1), td)
+	assert.Contains(t, string(<-t2.items), "ResourceSpans #0")
+
+	et.detach(t1)
+	assert.Len(t, *et.taps.Load(), 1)
+	et.detach(t2)
+	assert.Nil(t, et.taps.Load())
+}
+
+func TestTapZPages(t *testing.T) {
+	host := newTopologyHost(t, true)
+	mux := http.NewServeMux()
+	host.RegisterTapZPage(mux, "/debug", 5)
+	et, ok := host.Pipelines.edgeTapFor("receiver:nop:traces", "processor:nop:traces")
+	require.True(t, ok)
+
+	serve := func(ctx context.Context, query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/tapz"+query, nil).WithContext(ctx))
+		return rec
+	}
+
+	// stream serves the request while the batches of data are sent through the edge.
+	stream := func(query string, batches int) *httptest.ResponseRecorder {
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- serve(context.Background(), query) }()
+		assert.Eventually(t, func() bool { return et.taps.Load() != nil }, time.Second, time.Millisecond)
+		for i := 0; i < batches; i++ {
+			require.NoError(t, et.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+		}
+		rec := <-done
+		assert.Nil(t, et.taps.Load())
+		return rec
+	}
+
+	rec := stream("?from=receiver:nop:traces&to=processor:nop:traces&items=2", 3)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, 2, strings.Count(rec.Body.String(), "ResourceSpans #0"))
+
+	rec = stream("?from=receiver:nop:traces&to=processor:nop:traces&format=json", 5)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	lines := 0
+	for scanner := bufio.NewScanner(rec.Body); scanner.Scan(); lines++ {
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(scanner.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, testdata.GenerateTraces(1), td)
+	}
+	assert.Equal(t, 5, lines)
+
+	// The tap is detached when the client disconnects.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- serve(ctx, "?from=processor:nop:traces&to=fanout:traces") }()
+	fanOutTap, ok := host.Pipelines.edgeTapFor("processor:nop:traces", "fanout:traces")
+	require.True(t, ok)
+	assert.Eventually(t, func() bool { return fanOutTap.taps.Load() != nil }, time.Second, time.Millisecond)
+	cancel()
+	assert.Equal(t, http.StatusOK, (<-done).Code)
+	assert.Nil(t, fanOutTap.taps.Load())
+
+	for _, tt := range []struct {
+		query string
+		code  int
+		err   string
+	}{
+		{query: "", code: http.StatusBadRequest, err: `the "from" and "to" params are required, the edges are listed by topologyz`},
+		{query: "?from=receiver:nop:traces&to=processor:nop:traces&format=xml", code: http.StatusBadRequest, err: `invalid format "xml", must be "text" or "json"`},
+		{query: "?from=receiver:nop:traces&to=processor:nop:traces&items=0", code: http.StatusBadRequest, err: `invalid items "0", must be between 1 and 5`},
+		{query: "?from=receiver:nop:traces&to=processor:nop:traces&items=6", code: http.StatusBadRequest, err: `invalid items "6", must be between 1 and 5`},
+		{query: "?from=receiver:nop:traces&to=exporter:nop:traces", code: http.StatusNotFound, err: `edge from "receiver:nop:traces" to "exporter:nop:traces" not found`},
+	} {
+		rec = serve(context.Background(), tt.query)
+		assert.Equal(t, tt.code, rec.Code)
+		assert.Contains(t, rec.Body.String(), tt.err)
+	}
+}
+
+func TestEdgeTapsDisabled(t *testing.T) {
+	host := newTopologyHost(t, false)
+	assert.Empty(t, host.Pipelines.edgeTaps)
+
+	mux := http.NewServeMux()
+	host.RegisterTapZPage(mux, "/debug", 5)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/tapz?from=receiver:nop:traces&to=processor:nop:traces", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEdgeTapForConnector(t *testing.T) {
+	host := newTopologyHost(t, true)
+	for _, edge := range [][2]string{
+		{"fanout:traces", "connector:nop/conn:traces:logs"},
+		{"connector:nop/conn:traces:logs", "fanout:logs"},
+		{"fanout:logs", "exporter:nop:logs"},
+	} {
+		_, ok := host.Pipelines.edgeTapFor(edge[0], edge[1])
+		assert.True(t, ok, "%s -> %s", edge[0], edge[1])
+	}
+}
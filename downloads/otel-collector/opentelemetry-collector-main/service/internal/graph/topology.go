@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph // import "go.opentelemetry.io/collector/service/internal/graph"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gonum.org/v1/gonum/graph"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/service/internal/status"
+)
+
+const (
+	// URL Params
+	zTopologyFormat = "format"
+
+	topologyFormatJSON = "json"
+	topologyFormatDOT  = "dot"
+
+	topologyKindReceiver  = "receiver"
+	topologyKindProcessor = "processor"
+	topologyKindExporter  = "exporter"
+	topologyKindConnector = "connector"
+	topologyKindFanOut    = "fanout"
+)
+
+// topology is the machine-readable representation of the pipelines graph.
+type topology struct {
+	Nodes []topologyNode `json:"nodes"`
+	Edges []topologyEdge `json:"edges"`
+}
+
+// topologyNode is a node of the pipelines graph: a component instance, or the fan-out to the exporters of a pipeline.
+type topologyNode struct {
+	// ID identifies the node in the topology, like "processor:batch:traces/2".
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	// Component is the ID of the component, empty for the fan-out nodes.
+	Component string   `json:"component,omitempty"`
+	Pipelines []string `json:"pipelines"`
+	// DataTypes are the data types the node consumes, and for the connectors the data type they emit too.
+	DataTypes []string `json:"data_types"`
+	Stability string   `json:"stability,omitempty"`
+	Status    string   `json:"status,omitempty"`
+}
+
+// topologyEdge is the flow of data from a node to another.
+type topologyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// topology returns the topology of the graph, with the current status of the components reported to the reporter.
+// The capabilities nodes, internal to the pipelines, are left out: the receivers are linked to the nodes they emit to.
+func (g *Graph) topology(reporter status.Reporter) *topology {
+	t := &topology{Nodes: []topologyNode{}, Edges: []topologyEdge{}}
+	ids := make(map[int64]string)
+	for it := g.componentGraph.Nodes(); it.Next(); {
+		node, ok := g.topologyNode(it.Node(), reporter)
+		if !ok {
+			continue
+		}
+		ids[it.Node().ID()] = node.ID
+		t.Nodes = append(t.Nodes, node)
+	}
+
+	for it := g.componentGraph.Edges(); it.Next(); {
+		from, ok := ids[it.Edge().From().ID()]
+		if !ok {
+			continue
+		}
+		to := it.Edge().To()
+		if _, isCapabilities := to.(*capabilitiesNode); !isCapabilities {
+			t.Edges = append(t.Edges, topologyEdge{From: from, To: ids[to.ID()]})
+			continue
+		}
+		for succ := g.componentGraph.From(to.ID()); succ.Next(); {
+			t.Edges = append(t.Edges, topologyEdge{From: from, To: ids[succ.Node().ID()]})
+		}
+	}
+
+	sort.Slice(t.Nodes, func(i, j int) bool { return t.Nodes[i].ID < t.Nodes[j].ID })
+	sort.Slice(t.Edges, func(i, j int) bool {
+		if t.Edges[i].From != t.Edges[j].From {
+			return t.Edges[i].From < t.Edges[j].From
+		}
+		return t.Edges[i].To < t.Edges[j].To
+	})
+	return t
+}
+
+func (g *Graph) topologyNode(node graph.Node, reporter status.Reporter) (topologyNode, bool) {
+	id, ok := topologyNodeID(node)
+	if !ok {
+		return topologyNode{}, false
+	}
+	tn := topologyNode{ID: id}
+	switch n := node.(type) {
+	case *receiverNode:
+		tn.Kind = topologyKindReceiver
+		tn.Component = n.componentID.String()
+		tn.DataTypes = []string{n.pipelineType.String()}
+		tn.Stability = g.settings.ReceiverBuilder.Stability(n.componentID.Type(), n.pipelineType).String()
+	case *processorNode:
+		tn.Kind = topologyKindProcessor
+		tn.Component = n.componentID.String()
+		tn.DataTypes = []string{n.pipelineID.Type().String()}
+		tn.Stability = g.settings.ProcessorBuilder.Stability(n.componentID.Type(), n.pipelineID.Type()).String()
+	case *exporterNode:
+		tn.Kind = topologyKindExporter
+		tn.Component = n.componentID.String()
+		tn.DataTypes = []string{n.pipelineType.String()}
+		tn.Stability = g.settings.ExporterBuilder.Stability(n.componentID.Type(), n.pipelineType).String()
+	case *connectorNode:
+		tn.Kind = topologyKindConnector
+		tn.Component = n.componentID.String()
+		tn.DataTypes = []string{n.exprPipelineType.String()}
+		tn.Stability = g.settings.ConnectorBuilder.Stability(n.componentID.Type(), n.exprPipelineType, n.rcvrPipelineType).String()
+		if n.rcvrPipelineType != n.exprPipelineType {
+			tn.DataTypes = append(tn.DataTypes, n.rcvrPipelineType.String())
+		}
+	case *fanOutNode:
+		tn.Kind = topologyKindFanOut
+		tn.Pipelines = []string{n.pipelineID.String()}
+		tn.DataTypes = []string{n.pipelineID.Type().String()}
+		return tn, true
+	}
+
+	tn.Pipelines = []string{}
+	if instanceID, ok := g.instanceIDs[node.ID()]; ok {
+		instanceID.AllPipelineIDs(func(id component.ID) bool {
+			tn.Pipelines = append(tn.Pipelines, id.String())
+			return true
+		})
+		sort.Strings(tn.Pipelines)
+		tn.Status = reporter.Status(instanceID).String()
+	}
+	return tn, true
+}
+
+// topologyNodeID returns the ID of the node in the topology, or false for the capabilities nodes left out of it.
+func topologyNodeID(node graph.Node) (string, bool) {
+	switch n := node.(type) {
+	case *receiverNode:
+		return fmt.Sprintf("%s:%s:%s", topologyKindReceiver, n.componentID, n.pipelineType), true
+	case *processorNode:
+		return fmt.Sprintf("%s:%s:%s", topologyKindProcessor, n.componentID, n.pipelineID), true
+	case *exporterNode:
+		return fmt.Sprintf("%s:%s:%s", topologyKindExporter, n.componentID, n.pipelineType), true
+	case *connectorNode:
+		return fmt.Sprintf("%s:%s:%s:%s", topologyKindConnector, n.componentID, n.exprPipelineType, n.rcvrPipelineType), true
+	case *fanOutNode:
+		return fmt.Sprintf("%s:%s", topologyKindFanOut, n.pipelineID), true
+	}
+	return "", false
+}
+
+// writeDOT writes the topology as a Graphviz DOT digraph. The components are colored by status.
+func (t *topology) writeDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph pipelines {\n\trankdir=LR;\n\tnode [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+	for _, n := range t.Nodes {
+		if n.Kind == topologyKindFanOut {
+			fmt.Fprintf(&b, "\t%q [label=\"\", shape=point];\n", n.ID)
+			continue
+		}
+		label := fmt.Sprintf("%s\n%s (%s)\n%s", n.Component, n.Kind, strings.Join(n.DataTypes, " to "), n.Stability)
+		fmt.Fprintf(&b, "\t%q [label=%q, fillcolor=%q, tooltip=%q];\n", n.ID, label, statusColor(n.Status), n.Status)
+	}
+	for _, e := range t.Edges {
+		fmt.Fprintf(&b, "\t%q -> %q;\n", e.From, e.To)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// statusColor returns the fill color of the nodes with the given status.
+func statusColor(s string) string {
+	switch s {
+	case componentstatus.StatusOK.String():
+		return "palegreen"
+	case componentstatus.StatusRecoverableError.String():
+		return "orange"
+	case componentstatus.StatusPermanentError.String(), componentstatus.StatusFatalError.String():
+		return "tomato"
+	}
+	return "white"
+}
+
+// handleTopologyZPages serves the topology of the graph as JSON, or as DOT with the "format=dot" URL param.
+func (g *Graph) handleTopologyZPages(w http.ResponseWriter, r *http.Request, reporter status.Reporter) {
+	t := g.topology(reporter)
+	switch format := r.URL.Query().Get(zTopologyFormat); format {
+	case "", topologyFormatJSON:
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(t); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	case topologyFormatDOT:
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		if err := t.writeDOT(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("invalid format %q, must be %q or %q", format, topologyFormatJSON, topologyFormatDOT), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/service/internal/builders"
+	"go.opentelemetry.io/collector/service/internal/status"
+	"go.opentelemetry.io/collector/service/pipelines"
+)
+
+func newTopologyHost(t *testing.T, enableTaps bool) *Host {
+	nopID := component.MustNewID("nop")
+	connID := component.MustNewIDWithName("nop", "conn")
+	set := Settings{
+		Telemetry:        componenttest.NewNopTelemetrySettings(),
+		BuildInfo:        component.NewDefaultBuildInfo(),
+		ReceiverBuilder:  builders.NewReceiver(builders.NewNopReceiverConfigsAndFactories()),
+		ProcessorBuilder: builders.NewProcessor(builders.NewNopProcessorConfigsAndFactories()),
+		ExporterBuilder:  builders.NewExporter(builders.NewNopExporterConfigsAndFactories()),
+		ConnectorBuilder: builders.NewConnector(builders.NewNopConnectorConfigsAndFactories()),
+		PipelineConfigs: pipelines.Config{
+			component.MustNewID("traces"): {
+				Receivers:  []component.ID{nopID},
+				Processors: []component.ID{nopID},
+				Exporters:  []component.ID{nopID, connID},
+			},
+			component.MustNewID("logs"): {
+				Receivers: []component.ID{connID},
+				Exporters: []component.ID{nopID},
+			},
+		},
+		EnableTaps: enableTaps,
+	}
+	pg, err := Build(context.Background(), set)
+	require.NoError(t, err)
+
+	reporter := status.NewReporter(func(*componentstatus.InstanceID, *componentstatus.Event) {}, func(error) {})
+	reporter.Ready()
+	host := &Host{Pipelines: pg, Reporter: reporter}
+	require.NoError(t, pg.StartAll(context.Background(), host))
+	t.Cleanup(func() { assert.NoError(t, pg.ShutdownAll(context.Background(), reporter)) })
+	return host
+}
+
+func TestTopology(t *testing.T) {
+	host := newTopologyHost(t, false)
+	topo := host.Pipelines.topology(host.Reporter)
+
+	assert.Equal(t, []topologyNode{
+		{ID: "connector:nop/conn:traces:logs", Kind: "connector", Component: "nop/conn", Pipelines: []string{"logs", "traces"}, DataTypes: []string{"traces", "logs"}, Stability: "Development", Status: "StatusOK"},
+		{ID: "exporter:nop:logs", Kind: "exporter", Component: "nop", Pipelines: []string{"logs"}, DataTypes: []string{"logs"}, Stability: "Stable", Status: "StatusOK"},
+		{ID: "exporter:nop:traces", Kind: "exporter", Component: "nop", Pipelines: []string{"traces"}, DataTypes: []string{"traces"}, Stability: "Stable", Status: "StatusOK"},
+		{ID: "fanout:logs", Kind: "fanout", Pipelines: []string{"logs"}, DataTypes: []string{"logs"}},
+		{ID: "fanout:traces", Kind: "fanout", Pipelines: []string{"traces"}, DataTypes: []string{"traces"}},
+		{ID: "processor:nop:traces", Kind: "processor", Component: "nop", Pipelines: []string{"traces"}, DataTypes: []string{"traces"}, Stability: "Stable", Status: "StatusOK"},
+		{ID: "receiver:nop:traces", Kind: "receiver", Component: "nop", Pipelines: []string{"traces"}, DataTypes: []string{"traces"}, Stability: "Stable", Status: "StatusOK"},
+	}, topo.Nodes)
+
+	assert.Equal(t, []topologyEdge{
+		{From: "connector:nop/conn:traces:logs", To: "fanout:logs"},
+		{From: "fanout:logs", To: "exporter:nop:logs"},
+		{From: "fanout:traces", To: "connector:nop/conn:traces:logs"},
+		{From: "fanout:traces", To: "exporter:nop:traces"},
+		{From: "processor:nop:traces", To: "fanout:traces"},
+		{From: "receiver:nop:traces", To: "processor:nop:traces"},
+	}, topo.Edges)
+}
+
+func TestTopologyZPages(t *testing.T) {
+	host := newTopologyHost(t, false)
+	mux := http.NewServeMux()
+	host.RegisterZPages(mux, "/debug")
+
+	get := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/topologyz"+query, nil))
+		return rec
+	}
+
+	rec := get("")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var topo topology
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &topo))
+	assert.Equal(t, host.Pipelines.topology(host.Reporter), &topo)
+
+	rec = get("?format=dot")
+	require.Equal(t, http.StatusOK, rec.Code)
+	dot := rec.Body.String()
+	assert.True(t, strings.HasPrefix(dot, "digraph pipelines {\n"))
+	assert.Contains(t, dot, "\t\"receiver:nop:traces\" [label=\"nop\\nreceiver (traces)\\nStable\", fillcolor=\"palegreen\", tooltip=\"StatusOK\"];\n")
+	assert.Contains(t, dot, "\t\"connector:nop/conn:traces:logs\" [label=\"nop/conn\\nconnector (traces to logs)\\nDevelopment\"")
+	assert.Contains(t, dot, "\t\"fanout:traces\" [label=\"\", shape=point];\n")
+	assert.Contains(t, dot, "\t\"receiver:nop:traces\" -> \"processor:nop:traces\";\n")
+
+	rec = get("?format=xml")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `invalid format "xml", must be "json" or "dot"`)
+}
+
+func TestStatusColor(t *testing.T) {
+	assert.Equal(t, "palegreen", statusColor(componentstatus.StatusOK.String()))
+	assert.Equal(t, "orange", statusColor(componentstatus.StatusRecoverableError.String()))
+	assert.Equal(t, "tomato", statusColor(componentstatus.StatusFatalError.String()))
+	assert.Equal(t, "white", statusColor(componentstatus.StatusStarting.String()))
+	assert.Equal(t, "white", statusColor(""))
+}
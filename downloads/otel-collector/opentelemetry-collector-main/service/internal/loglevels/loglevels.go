@@ -0,0 +1,282 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package loglevels holds the logging levels of the collector and of its components, which can be changed at runtime.
+package loglevels // import "go.opentelemetry.io/collector/service/internal/loglevels"
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Registry holds the logging level of the collector, and the levels configured or set at runtime for some of
+// its components. A component logs at the level set for it at runtime, or else at its configured level,
+// or else at the level of the collector.
+type Registry struct {
+	mu sync.Mutex
+	// level is the level of the collector.
+	level zap.AtomicLevel
+	// overrides are the levels set for the components at runtime, by component key.
+	overrides map[string]zapcore.Level
+	// levels are the levels the loggers of the components log at, by component key.
+	levels map[string]zap.AtomicLevel
+	// selectors are the configured levels, which apply to the components without a level set at runtime.
+	selectors []selector
+}
+
+// selector is a level configured for the components of a kind, with an ID, or both.
+type selector struct {
+	kind  component.Kind
+	id    component.ID
+	level zapcore.Level
+}
+
+// NewRegistry returns a registry with the given level for the collector, and the levels configured for
+// the components by kind, like "exporter", by ID, like "otlp/2", or by both, like "exporter/otlp/2".
+// A component logs at the level of its kind and ID, or else of its ID, or else of its kind, or else of the collector.
+func NewRegistry(level zapcore.Level, componentLevels map[string]zapcore.Level) (*Registry, error) {
+	r := &Registry{
+		level:     zap.NewAtomicLevelAt(level),
+		overrides: make(map[string]zapcore.Level),
+		levels:    make(map[string]zap.AtomicLevel),
+	}
+	for key, l := range componentLevels {
+		sel, err := parseSelector(key)
+		if err != nil {
+			return nil, err
+		}
+		sel.level = l
+		r.selectors = append(r.selectors, sel)
+	}
+	return r, nil
+}
+
+// ValidateComponentLevels returns an error if a key of the levels configured for the components
+// isn't a kind, an ID or both.
+func ValidateComponentLevels(componentLevels map[string]zapcore.Level) error {
+	for key := range componentLevels {
+		if _, err := parseSelector(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSelector parses a kind, an ID or both. The kinds take precedence over the IDs with the same type.
+func parseSelector(key string) (selector, error) {
+	if kind, ok := parseKind(key); ok {
+		return selector{kind: kind}, nil
+	}
+	if kindName, idStr, found := strings.Cut(key, "/"); found {
+		if kind, ok := parseKind(kindName); ok {
+			var id component.ID
+			if err := id.UnmarshalText([]byte(idStr)); err != nil {
+				return selector{}, fmt.Errorf("invalid component %q: %w", key, err)
+			}
+			return selector{kind: kind, id: id}, nil
+		}
+	}
+	var id component.ID
+	if err := id.UnmarshalText([]byte(key)); err != nil {
+		return selector{}, fmt.Errorf("invalid component %q, must be a kind, an ID or <kind>/<id>: %w", key, err)
+	}
+	return selector{id: id}, nil
+}
+
+// configuredLevel returns the level configured for the component, if any.
+func (r *Registry) configuredLevel(kind component.Kind, id component.ID) (zapcore.Level, bool) {
+	var match *selector
+	rank := 0
+	for i, sel := range r.selectors {
+		var selRank int
+		switch {
+		case sel.kind == kind && sel.id == id:
+			selRank = 3
+		case sel.kind == component.Kind(0) && sel.id == id:
+			selRank = 2
+		case sel.kind == kind && sel.id == component.ID{}:
+			selRank = 1
+		}
+		if selRank > rank {
+			match, rank = &r.selectors[i], selRank
+		}
+	}
+	if match == nil {
+		return zapcore.InvalidLevel, false
+	}
+	return match.level, true
+}
+
+// baseLevel returns the level of the component with the given key when no level is set for it at runtime:
+// its configured level, or else the level of the collector.
+func (r *Registry) baseLevel(key string) zapcore.Level {
+	if kind, id, err := ParseKey(key); err == nil {
+		if configured, ok := r.configuredLevel(kind, id); ok {
+			return configured
+		}
+	}
+	return r.level.Level()
+}
+
+// Key returns the key of a component, like "exporter/otlp/2".
+func Key(kind component.Kind, id component.ID) string {
+	return strings.ToLower(kind.String()) + "/" + id.String()
+}
+
+// ParseKey returns the kind and the ID of the component with the given key.
+func ParseKey(key string) (component.Kind, component.ID, error) {
+	kindName, idStr, ok := strings.Cut(key, "/")
+	if !ok {
+		return component.Kind(0), component.ID{}, fmt.Errorf("invalid component %q, must be <kind>/<id>", key)
+	}
+	kind, ok := parseKind(kindName)
+	if !ok {
+		return component.Kind(0), component.ID{}, fmt.Errorf("invalid component %q, unknown kind %q", key, kindName)
+	}
+	var id component.ID
+	if err := id.UnmarshalText([]byte(idStr)); err != nil {
+		return component.Kind(0), component.ID{}, fmt.Errorf("invalid component %q: %w", key, err)
+	}
+	return kind, id, nil
+}
+
+func parseKind(name string) (component.Kind, bool) {
+	switch name {
+	case "receiver":
+		return component.KindReceiver, true
+	case "processor":
+		return component.KindProcessor, true
+	case "exporter":
+		return component.KindExporter, true
+	case "extension":
+		return component.KindExtension, true
+	case "connector":
+		return component.KindConnector, true
+	}
+	return component.Kind(0), false
+}
+
+// Level returns the level of the collector.
+func (r *Registry) Level() zapcore.Level {
+	return r.level.Level()
+}
+
+// SetLevel sets the level of the collector, and of the components without a level of their own.
+func (r *Registry) SetLevel(level zapcore.Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.level.SetLevel(level)
+	for key, l := range r.levels {
+		if _, ok := r.overrides[key]; !ok {
+			l.SetLevel(r.baseLevel(key))
+		}
+	}
+}
+
+// ComponentLevels returns the levels set for the components at runtime, by component key.
+// The configured levels are not part of them.
+func (r *Registry) ComponentLevels() map[string]zapcore.Level {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	levels := make(map[string]zapcore.Level, len(r.overrides))
+	for key, level := range r.overrides {
+		levels[key] = level
+	}
+	return levels
+}
+
+// SetComponentLevel sets the level of the component with the given key at runtime, overriding its configured level.
+func (r *Registry) SetComponentLevel(key string, level zapcore.Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.overrides[key] = level
+	if l, ok := r.levels[key]; ok {
+		l.SetLevel(level)
+	}
+}
+
+// ResetComponentLevel removes the level set at runtime for the component with the given key,
+// which logs at its configured level again, or else at the level of the collector.
+func (r *Registry) ResetComponentLevel(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.overrides, key)
+	if l, ok := r.levels[key]; ok {
+		l.SetLevel(r.baseLevel(key))
+	}
+}
+
+// componentLevel returns the level the loggers of the component log at.
+func (r *Registry) componentLevel(kind component.Kind, id component.ID) zap.AtomicLevel {
+	key := Key(kind, id)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if l, ok := r.levels[key]; ok {
+		return l
+	}
+	level, ok := r.overrides[key]
+	if !ok {
+		level = r.baseLevel(key)
+	}
+	l := zap.NewAtomicLevelAt(level)
+	r.levels[key] = l
+	return l
+}
+
+// WrapCore returns a core logging to the given core at the level of the collector. The given core must be enabled
+// at all the levels, since the components can log at a lower level than the collector.
+func (r *Registry) WrapCore(core zapcore.Core) zapcore.Core {
+	return &levelCore{Core: core, level: r.level, registry: r}
+}
+
+// FromLogger returns the registry of the logger, or nil if the logger was not created with a registry.
+func FromLogger(logger *zap.Logger) *Registry {
+	if lc, ok := logger.Core().(*levelCore); ok {
+		return lc.registry
+	}
+	return nil
+}
+
+// ComponentLogger returns a logger logging at the level of the component, when the logger was created with a registry.
+// Otherwise, the logger is returned unchanged.
+func ComponentLogger(logger *zap.Logger, kind component.Kind, id component.ID) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		lc, ok := core.(*levelCore)
+		if !ok {
+			return core
+		}
+		return &levelCore{Core: lc.Core, level: lc.registry.componentLevel(kind, id), registry: lc.registry}
+	}))
+}
+
+// levelCore filters the entries of the wrapped core by a level which can be changed at runtime.
+type levelCore struct {
+	zapcore.Core
+	level    zap.AtomicLevel
+	registry *Registry
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *levelCore) Level() zapcore.Level {
+	return c.level.Level()
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level, registry: c.registry}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.level.Enabled(entry.Level) {
+		return ce
+	}
+	return c.Core.Check(entry, ce)
+}
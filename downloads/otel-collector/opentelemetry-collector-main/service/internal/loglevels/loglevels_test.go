@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loglevels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func TestParseKey(t *testing.T) {
+	id := component.MustNewIDWithName("otlp", "2")
+	kind, parsed, err := ParseKey(Key(component.KindExporter, id))
+	require.NoError(t, err)
+	assert.Equal(t, component.KindExporter, kind)
+	assert.Equal(t, id, parsed)
+
+	_, _, err = ParseKey("otlp")
+	require.EqualError(t, err, `invalid component "otlp", must be <kind>/<id>`)
+	_, _, err = ParseKey("pipeline/otlp")
+	require.EqualError(t, err, `invalid component "pipeline/otlp", unknown kind "pipeline"`)
+	_, _, err = ParseKey("receiver/")
+	require.ErrorContains(t, err, `invalid component "receiver/"`)
+}
+
+func newObservedLogger(t *testing.T, level zapcore.Level, componentLevels map[string]zapcore.Level) (*zap.Logger, *observer.ObservedLogs) {
+	registry, err := NewRegistry(level, componentLevels)
+	require.NoError(t, err)
+	core, logs := observer.New(zapcore.DebugLevel)
+	return zap.New(registry.WrapCore(core)), logs
+}
+
+func TestComponentLogger(t *testing.T) {
+	logger, logs := newObservedLogger(t, zapcore.InfoLevel, nil)
+	registry := FromLogger(logger)
+	require.NotNil(t, registry)
+	id := component.MustNewID("nop")
+	rcvLogger := ComponentLogger(logger, component.KindReceiver, id).With(zap.String("name", "rcv"))
+	expLogger := ComponentLogger(logger, component.KindExporter, id).With(zap.String("name", "exp"))
+
+	rcvLogger.Debug("dropped")
+	expLogger.Info("logged")
+	entries := logs.TakeAll()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "logged", entries[0].Message)
+
+	registry.SetComponentLevel(Key(component.KindReceiver, id), zapcore.DebugLevel)
+	rcvLogger.Debug("logged")
+	expLogger.Debug("dropped")
+	assert.Equal(t, 1, logs.Len())
+	assert.Equal(t, map[string]zapcore.Level{"receiver/nop": zapcore.DebugLevel}, registry.ComponentLevels())
+
+	registry.SetLevel(zapcore.ErrorLevel)
+	assert.Equal(t, zapcore.ErrorLevel, registry.Level())
+	assert.Equal(t, zapcore.ErrorLevel, logger.Level())
+	logger.Warn("dropped")
+	expLogger.Warn("dropped")
+	rcvLogger.Debug("logged")
+	assert.Equal(t, 2, logs.Len())
+
+	registry.ResetComponentLevel(Key(component.KindReceiver, id))
+	rcvLogger.Warn("dropped")
+	assert.Empty(t, registry.ComponentLevels())
+	assert.Equal(t, 2, logs.Len())
+}
+
+func TestComponentLoggerLevelSetBeforeCreation(t *testing.T) {
+	logger, logs := newObservedLogger(t, zapcore.InfoLevel, nil)
+	FromLogger(logger).SetComponentLevel("processor/batch", zapcore.DebugLevel)
+
+	ComponentLogger(logger, component.KindProcessor, component.MustNewID("batch")).Debug("logged")
+	assert.Equal(t, 1, logs.Len())
+}
+
+func TestComponentLoggerConfiguredLevels(t *testing.T) {
+	logger, _ := newObservedLogger(t, zapcore.InfoLevel, map[string]zapcore.Level{
+		"exporter":        zapcore.WarnLevel,
+		"otlp/2":          zapcore.ErrorLevel,
+		"exporter/otlp/2": zapcore.DebugLevel,
+		"nop":             zapcore.DebugLevel,
+	})
+	registry := FromLogger(logger)
+
+	for _, tt := range []struct {
+		kind     component.Kind
+		id       component.ID
+		expected zapcore.Level
+	}{
+		{kind: component.KindExporter, id: component.MustNewIDWithName("otlp", "2"), expected: zapcore.DebugLevel},
+		{kind: component.KindReceiver, id: component.MustNewIDWithName("otlp", "2"), expected: zapcore.ErrorLevel},
+		{kind: component.KindExporter, id: component.MustNewID("otlp"), expected: zapcore.WarnLevel},
+		{kind: component.KindExtension, id: component.MustNewID("nop"), expected: zapcore.DebugLevel},
+		{kind: component.KindProcessor, id: component.MustNewID("batch"), expected: zapcore.InfoLevel},
+	} {
+		assert.Equal(t, tt.expected, ComponentLogger(logger, tt.kind, tt.id).Level(), Key(tt.kind, tt.id))
+	}
+
+	// The configured levels are not levels set at runtime.
+	assert.Empty(t, registry.ComponentLevels())
+}
+
+func TestComponentLoggerResetConfiguredLevel(t *testing.T) {
+	logger, logs := newObservedLogger(t, zapcore.InfoLevel, map[string]zapcore.Level{"exporter": zapcore.WarnLevel})
+	registry := FromLogger(logger)
+	expLogger := ComponentLogger(logger, component.KindExporter, component.MustNewID("otlp"))
+	assert.Equal(t, zapcore.WarnLevel, expLogger.Level())
+
+	registry.SetComponentLevel("exporter/otlp", zapcore.DebugLevel)
+	assert.Equal(t, zapcore.DebugLevel, expLogger.Level())
+	assert.Equal(t, map[string]zapcore.Level{"exporter/otlp": zapcore.DebugLevel}, registry.ComponentLevels())
+
+	// The component logs at its configured level again, not at the level of the collector.
+	registry.ResetComponentLevel("exporter/otlp")
+	assert.Equal(t, zapcore.WarnLevel, expLogger.Level())
+	assert.Empty(t, registry.ComponentLevels())
+	expLogger.Info("dropped")
+	expLogger.Warn("logged")
+	assert.Equal(t, 1, logs.Len())
+
+	// The configured level still overrides the level of the collector.
+	registry.SetLevel(zapcore.DebugLevel)
+	assert.Equal(t, zapcore.WarnLevel, expLogger.Level())
+	assert.Equal(t, zapcore.DebugLevel, ComponentLogger(logger, component.KindReceiver, component.MustNewID("otlp")).Level())
+}
+
+func TestValidateComponentLevels(t *testing.T) {
+	require.NoError(t, ValidateComponentLevels(map[string]zapcore.Level{
+		"receiver":        zapcore.DebugLevel,
+		"otlp":            zapcore.DebugLevel,
+		"otlp/2":          zapcore.DebugLevel,
+		"exporter/otlp/2": zapcore.DebugLevel,
+	}))
+	require.ErrorContains(t, ValidateComponentLevels(map[string]zapcore.Level{"exporter/": zapcore.DebugLevel}), `invalid component "exporter/"`)
+	require.ErrorContains(t, ValidateComponentLevels(map[string]zapcore.Level{"otlp/": zapcore.DebugLevel}), `invalid component "otlp/", must be a kind, an ID or <kind>/<id>`)
+
+	_, err := NewRegistry(zapcore.InfoLevel, map[string]zapcore.Level{"": zapcore.DebugLevel})
+	require.Error(t, err)
+}
+
+func TestComponentLoggerWithoutRegistry(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+	assert.Nil(t, FromLogger(logger))
+
+	compLogger := ComponentLogger(logger, component.KindReceiver, component.MustNewID("nop"))
+	compLogger.Debug("dropped")
+	compLogger.Info("logged")
+	assert.Equal(t, 1, logs.Len())
+}
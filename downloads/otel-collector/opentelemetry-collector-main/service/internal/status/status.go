@@ -100,6 +100,9 @@ type Reporter interface {
 	Ready()
 	ReportStatus(id *componentstatus.InstanceID, ev *componentstatus.Event)
 	ReportOKIfStarting(id *componentstatus.InstanceID)
+	// Status returns the current status of the given InstanceID,
+	// componentstatus.StatusNone if it did not report any.
+	Status(id *componentstatus.InstanceID) componentstatus.Status
 }
 
 type reporter struct {
@@ -157,6 +160,16 @@ func (r *reporter) ReportOKIfStarting(id *componentstatus.InstanceID) {
 	}
 }
 
+// Status returns the current status of the given InstanceID
+func (r *reporter) Status(id *componentstatus.InstanceID) componentstatus.Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if fsm, ok := r.fsmMap[id]; ok {
+		return fsm.current.Status()
+	}
+	return componentstatus.StatusNone
+}
+
 // Note: a lock must be acquired before calling this method.
 func (r *reporter) componentFSM(id *componentstatus.InstanceID) *fsm {
 	fsm, ok := r.fsmMap[id]
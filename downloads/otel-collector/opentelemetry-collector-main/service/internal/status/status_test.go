@@ -277,6 +277,19 @@ func TestReporterReady(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReporterStatus(t *testing.T) {
+	rep := NewReporter(func(*componentstatus.InstanceID, *componentstatus.Event) {}, func(error) {})
+	rep.Ready()
+	id := &componentstatus.InstanceID{}
+	require.Equal(t, componentstatus.StatusNone, rep.Status(id))
+
+	rep.ReportStatus(id, componentstatus.NewEvent(componentstatus.StatusStarting))
+	require.Equal(t, componentstatus.StatusStarting, rep.Status(id))
+	rep.ReportOKIfStarting(id)
+	require.Equal(t, componentstatus.StatusOK, rep.Status(id))
+	require.Equal(t, componentstatus.StatusNone, rep.Status(&componentstatus.InstanceID{}))
+}
+
 func TestReportComponentOKIfStarting(t *testing.T) {
 	for _, tt := range []struct {
 		name             string
@@ -14,10 +14,12 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/connector"
@@ -31,6 +33,7 @@ import (
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/internal/builders"
 	"go.opentelemetry.io/collector/service/internal/graph"
+	"go.opentelemetry.io/collector/service/internal/loglevels"
 	"go.opentelemetry.io/collector/service/internal/proctelemetry"
 	"go.opentelemetry.io/collector/service/internal/resource"
 	"go.opentelemetry.io/collector/service/internal/status"
@@ -83,6 +86,9 @@ type Settings struct {
 	// AsyncErrorChannel is the channel that is used to report fatal errors.
 	AsyncErrorChannel chan error
 
+	// ReloadChannel is the channel that is used to request a reload of the configuration.
+	ReloadChannel chan struct{}
+
 	// LoggingOptions provides a way to change behavior of zap logging.
 	LoggingOptions []zap.Option
 }
@@ -109,6 +115,7 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 			ModuleInfo:        set.ModuleInfo,
 			BuildInfo:         set.BuildInfo,
 			AsyncErrorChannel: set.AsyncErrorChannel,
+			ReloadChannel:     set.ReloadChannel,
 		},
 		collectorConf: set.CollectorConf,
 	}
@@ -155,6 +162,7 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 		// Construct telemetry attributes from build info and config's resource attributes.
 		Resource: pcommonRes,
 	}
+	srv.host.LogLevels = loglevels.FromLogger(logger)
 	srv.host.Reporter = status.NewReporter(srv.host.NotifyComponentStatusChange, func(err error) {
 		if errors.Is(err, status.ErrStatusNotReady) {
 			logger.Warn("Invalid transition", zap.Error(err))
@@ -162,13 +170,16 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 		// ignore other errors as they represent invalid state transitions and are considered benign.
 	})
 
-	if err = srv.initGraph(ctx, cfg); err != nil {
+	// The extensions are created before the pipelines, which wrap their consumers in edge taps if an extension
+	// registers the tapz zPage.
+	if err = srv.initExtensions(ctx, cfg.Extensions); err != nil {
 		err = multierr.Append(err, srv.shutdownTelemetry(ctx))
 		return nil, err
 	}
 
 	// process the configuration and initialize the pipeline
-	if err = srv.initExtensions(ctx, cfg.Extensions); err != nil {
+	if err = srv.initGraph(ctx, cfg); err != nil {
+		err = multierr.Append(err, srv.host.ServiceExtensions.Shutdown(ctx))
 		err = multierr.Append(err, srv.shutdownTelemetry(ctx))
 		return nil, err
 	}
@@ -288,6 +299,108 @@ func (srv *Service) Shutdown(ctx context.Context) error {
 	return errs
 }
 
+// Reload applies the pipelines and components configuration of the given settings and config to the running service.
+// Only the components whose configuration changed, and the components upstream of them, are shut down and replaced;
+// the other components keep running. The telemetry and the extensions are not reloaded.
+//
+// If the new components cannot be created, an error is returned and the service keeps running with the previous
+// configuration. If an error is returned once the components are being replaced, the service should be shut down.
+func (srv *Service) Reload(ctx context.Context, set Settings, cfg Config) error {
+	receivers := builders.NewReceiver(set.ReceiversConfigs, set.ReceiversFactories)
+	processors := builders.NewProcessor(set.ProcessorsConfigs, set.ProcessorsFactories)
+	exporters := builders.NewExporter(set.ExportersConfigs, set.ExportersFactories)
+	connectors := builders.NewConnector(set.ConnectorsConfigs, set.ConnectorsFactories)
+
+	pipelines, err := srv.host.Pipelines.Rebuild(ctx, graph.Settings{
+		Telemetry:        srv.telemetrySettings,
+		BuildInfo:        srv.buildInfo,
+		ReceiverBuilder:  receivers,
+		ProcessorBuilder: processors,
+		ExporterBuilder:  exporters,
+		ConnectorBuilder: connectors,
+		PipelineConfigs:  cfg.Pipelines,
+		EnableTaps:       srv.tapsEnabled(),
+		ReportStatus:     srv.host.Reporter.ReportStatus,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build pipelines: %w", err)
+	}
+
+	srv.telemetrySettings.Logger.Info("Reloading pipelines...")
+	var errs error
+	if err = srv.host.ServiceExtensions.NotifyPipelineNotReady(); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to notify that pipeline is not ready: %w", err))
+	}
+	if err = srv.host.Pipelines.ShutdownReplaced(ctx, srv.host.Reporter, pipelines); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to shutdown replaced components: %w", err))
+	}
+
+	srv.host.ReplacePipelines(receivers, processors, exporters, connectors, pipelines, set.ModuleInfo)
+	srv.collectorConf = set.CollectorConf
+
+	if err = pipelines.StartAll(ctx, srv.host); err != nil {
+		return multierr.Append(errs, fmt.Errorf("cannot start pipelines: %w", err))
+	}
+	if err = srv.host.ServiceExtensions.NotifyPipelineReady(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	if srv.collectorConf != nil {
+		if err = srv.host.ServiceExtensions.NotifyConfig(ctx, srv.collectorConf); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	if errs == nil {
+		srv.telemetrySettings.Logger.Info("Pipelines reloaded.")
+	}
+	return errs
+}
+
+// Validate builds the extensions and the pipelines of the given config, without starting them nor setting up the
+// telemetry, and shuts them down. It returns the errors found when connecting and creating the components, like the
+// connectors not used on both sides or the cycles in the pipelines, which are not detected by Config.Validate.
+func Validate(ctx context.Context, set Settings, cfg Config) error {
+	tel := component.TelemetrySettings{
+		Logger:         zap.NewNop(),
+		TracerProvider: tracenoop.NewTracerProvider(),
+		MeterProvider:  noop.NewMeterProvider(),
+		LeveledMeterProvider: func(configtelemetry.Level) metric.MeterProvider {
+			return noop.NewMeterProvider()
+		},
+		MetricsLevel: cfg.Telemetry.Metrics.Level,
+		Resource:     pcommon.NewResource(),
+	}
+	reporter := status.NewReporter(func(*componentstatus.InstanceID, *componentstatus.Event) {}, func(error) {})
+
+	pipelines, err := graph.Build(ctx, graph.Settings{
+		Telemetry:        tel,
+		BuildInfo:        set.BuildInfo,
+		ReceiverBuilder:  builders.NewReceiver(set.ReceiversConfigs, set.ReceiversFactories),
+		ProcessorBuilder: builders.NewProcessor(set.ProcessorsConfigs, set.ProcessorsFactories),
+		ExporterBuilder:  builders.NewExporter(set.ExportersConfigs, set.ExportersFactories),
+		ConnectorBuilder: builders.NewConnector(set.ConnectorsConfigs, set.ConnectorsFactories),
+		PipelineConfigs:  cfg.Pipelines,
+		ReportStatus:     reporter.ReportStatus,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build pipelines: %w", err)
+	}
+	// The components were created but never started, they are shut down to release what they allocated.
+	errs := pipelines.ShutdownAll(ctx, reporter)
+
+	exts, err := extensions.New(ctx, extensions.Settings{
+		Telemetry:  tel,
+		BuildInfo:  set.BuildInfo,
+		Extensions: builders.NewExtension(set.ExtensionsConfigs, set.ExtensionsFactories),
+		ModuleInfo: set.ModuleInfo,
+	}, cfg.Extensions)
+	if err != nil {
+		return multierr.Append(errs, fmt.Errorf("failed to build extensions: %w", err))
+	}
+	return multierr.Append(errs, exts.Shutdown(ctx))
+}
+
 // Creates extensions.
 func (srv *Service) initExtensions(ctx context.Context, cfg extensions.Config) error {
 	var err error
@@ -314,6 +427,7 @@ func (srv *Service) initGraph(ctx context.Context, cfg Config) error {
 		ExporterBuilder:  srv.host.Exporters,
 		ConnectorBuilder: srv.host.Connectors,
 		PipelineConfigs:  cfg.Pipelines,
+		EnableTaps:       srv.tapsEnabled(),
 		ReportStatus:     srv.host.Reporter.ReportStatus,
 	}); err != nil {
 		return fmt.Errorf("failed to build pipelines: %w", err)
@@ -321,6 +435,16 @@ func (srv *Service) initGraph(ctx context.Context, cfg Config) error {
 	return nil
 }
 
+// tapsEnabled returns whether an extension registers the tapz zPage, which attaches taps to the edges of the pipelines.
+func (srv *Service) tapsEnabled() bool {
+	for _, ext := range srv.host.ServiceExtensions.GetExtensions() {
+		if tapExt, ok := ext.(interface{ TapZPageEnabled() bool }); ok && tapExt.TapZPageEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
 // Logger returns the logger created for this service.
 // This is a temporary API that may be removed soon after investigating how the collector should record different events.
 func (srv *Service) Logger() *zap.Logger {
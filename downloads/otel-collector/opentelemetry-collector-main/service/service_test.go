@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
@@ -240,6 +241,150 @@ func TestServiceGetExporters(t *testing.T) {
 	assert.Contains(t, expMap[componentprofiles.DataTypeProfiles], component.NewID(nopType))
 }
 
+func TestServiceReload(t *testing.T) {
+	set := newNopSettings()
+	srv, err := New(context.Background(), set, newNopConfig())
+	require.NoError(t, err)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	cfg := newNopConfigPipelineConfigs(pipelines.Config{
+		component.MustNewID("traces"): {
+			Receivers: []component.ID{component.NewID(nopType)},
+			Exporters: []component.ID{component.NewID(nopType)},
+		},
+	})
+	require.NoError(t, srv.Reload(context.Background(), set, cfg))
+
+	// nolint
+	expMap := srv.host.GetExporters()
+	assert.Len(t, expMap[component.DataTypeTraces], 1)
+	assert.Contains(t, expMap[component.DataTypeTraces], component.NewID(nopType))
+	assert.Empty(t, expMap[component.DataTypeMetrics])
+	assert.Empty(t, expMap[component.DataTypeLogs])
+
+	// An invalid configuration leaves the running pipelines untouched.
+	invalidCfg := newNopConfig()
+	invalidCfg.Pipelines[component.MustNewID("traces")].Processors[0] = component.MustNewID("invalid")
+	require.Error(t, srv.Reload(context.Background(), set, invalidCfg))
+	// nolint
+	assert.Equal(t, expMap, srv.host.GetExporters())
+}
+
+// pipelineWatcherExtension records the notifications of the pipeline watchers.
+type pipelineWatcherExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	events *[]string
+}
+
+func (e *pipelineWatcherExtension) Ready() error {
+	*e.events = append(*e.events, "ready")
+	return nil
+}
+
+func (e *pipelineWatcherExtension) NotReady() error {
+	*e.events = append(*e.events, "not ready")
+	return nil
+}
+
+func TestServiceReloadNotifiesPipelineWatchers(t *testing.T) {
+	var events []string
+	watcherType := component.MustNewType("watcher")
+	set := newNopSettings()
+	set.ExtensionsConfigs[component.NewID(watcherType)] = &struct{}{}
+	set.ExtensionsFactories[watcherType] = extension.NewFactory(watcherType, func() component.Config { return &struct{}{} },
+		func(context.Context, extension.Settings, component.Config) (extension.Extension, error) {
+			return &pipelineWatcherExtension{events: &events}, nil
+		}, component.StabilityLevelDevelopment)
+	cfg := newNopConfig()
+	cfg.Extensions = append(cfg.Extensions, component.NewID(watcherType))
+
+	srv, err := New(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+	assert.Equal(t, []string{"ready"}, events)
+
+	cfg.Pipelines = pipelines.Config{
+		component.MustNewID("traces"): {
+			Receivers: []component.ID{component.NewID(nopType)},
+			Exporters: []component.ID{component.NewID(nopType)},
+		},
+	}
+	require.NoError(t, srv.Reload(context.Background(), set, cfg))
+	assert.Equal(t, []string{"ready", "not ready", "ready"}, events)
+}
+
+// tapExtension tells the host whether the tapz zPage is enabled, like the zpages extension.
+type tapExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	enabled bool
+}
+
+func (e *tapExtension) TapZPageEnabled() bool {
+	return e.enabled
+}
+
+func TestServiceEdgeTaps(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%t", enabled), func(t *testing.T) {
+			tapType := component.MustNewType("tap")
+			set := newNopSettings()
+			set.ExtensionsConfigs[component.NewID(tapType)] = &struct{}{}
+			set.ExtensionsFactories[tapType] = extension.NewFactory(tapType, func() component.Config { return &struct{}{} },
+				func(context.Context, extension.Settings, component.Config) (extension.Extension, error) {
+					return &tapExtension{enabled: enabled}, nil
+				}, component.StabilityLevelDevelopment)
+			cfg := newNopConfig()
+			cfg.Extensions = append(cfg.Extensions, component.NewID(tapType))
+
+			srv, err := New(context.Background(), set, cfg)
+			require.NoError(t, err)
+			require.NoError(t, srv.Start(context.Background()))
+			t.Cleanup(func() {
+				assert.NoError(t, srv.Shutdown(context.Background()))
+			})
+
+			// The edges can only be tapped when the consumers given to the components are wrapped in edge taps.
+			mux := http.NewServeMux()
+			srv.host.RegisterTapZPage(mux, "/debug", 1)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/tapz?from=receiver:nop:traces&to=processor:nop:traces", nil).WithContext(ctx))
+			if enabled {
+				assert.Equal(t, http.StatusOK, rec.Code)
+			} else {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(context.Background(), newNopSettings(), newNopConfig()))
+
+	// The connectors must be used as an exporter and as a receiver, which is only checked when building the graph.
+	cfg := newNopConfigPipelineConfigs(pipelines.Config{
+		component.MustNewID("traces"): {
+			Receivers: []component.ID{component.NewID(nopType)},
+			Exporters: []component.ID{component.NewIDWithName(nopType, "conn")},
+		},
+	})
+	assert.ErrorContains(t, Validate(context.Background(), newNopSettings(), cfg), "failed to build pipelines")
+
+	cfg = newNopConfig()
+	cfg.Extensions = append(cfg.Extensions, component.MustNewID("invalid"))
+	assert.ErrorContains(t, Validate(context.Background(), newNopSettings(), cfg), "failed to build extensions")
+}
+
 // TestServiceTelemetryCleanupOnError tests that if newService errors due to an invalid config telemetry is cleaned up
 // and another service with a valid config can be started right after.
 func TestServiceTelemetryCleanupOnError(t *testing.T) {
@@ -514,6 +659,32 @@ func TestServiceTelemetryLogger(t *testing.T) {
 	assert.NotNil(t, srv.telemetrySettings.Logger)
 }
 
+func TestServiceTelemetryComponentLevels(t *testing.T) {
+	cfg := newNopConfig()
+	cfg.Telemetry.Logs.ComponentLevels = map[string]zapcore.Level{
+		"exporter":      zapcore.DebugLevel,
+		"extension/nop": zapcore.WarnLevel,
+	}
+	srv, err := New(context.Background(), newNopSettings(), cfg)
+	require.NoError(t, err)
+
+	assert.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+	// The configured levels are not reported as levels set at runtime.
+	level, levels, err := srv.host.GetLogLevels()
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.InfoLevel, level)
+	assert.Empty(t, levels)
+
+	require.NoError(t, srv.host.SetLogLevel("exporter/nop", zapcore.ErrorLevel))
+	require.NoError(t, srv.host.ResetLogLevel("exporter/nop"))
+	_, levels, err = srv.host.GetLogLevels()
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+}
+
 func TestServiceFatalError(t *testing.T) {
 	set := newNopSettings()
 	set.AsyncErrorChannel = make(chan error)
@@ -623,6 +794,7 @@ func assertZPages(t *testing.T, zpagesAddr string) {
 		"/debug/pipelinez",
 		"/debug/servicez",
 		"/debug/extensionz",
+		"/debug/topologyz",
 	}
 
 	testZPagePathFn := func(t *testing.T, path string) {
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/service/internal/loglevels"
 )
 
 // Config defines the configurable settings for service telemetry.
@@ -34,6 +35,18 @@ type LogsConfig struct {
 	// (default = "INFO")
 	Level zapcore.Level `mapstructure:"level"`
 
+	// ComponentLevels are the minimum enabled logging levels of the components, overriding Level.
+	// The keys are a component kind, a component ID, or both, and the most specific key applies.
+	// Example:
+	//
+	// 		component_levels:
+	//	   		exporter: warn
+	//	   		otlp/2: info
+	//	   		exporter/otlp/2: debug
+	//
+	// By default, the components log at Level.
+	ComponentLevels map[string]zapcore.Level `mapstructure:"component_levels"`
+
 	// Development puts the logger in development mode, which changes the
 	// behavior of DPanicLevel and takes stacktraces more liberally.
 	// (default = false)
@@ -150,5 +163,9 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("collector telemetry metric address or reader should exist when metric level is not none")
 	}
 
+	if err := loglevels.ValidateComponentLevels(c.Logs.ComponentLevels); err != nil {
+		return fmt.Errorf("invalid logs component_levels: %w", err)
+	}
+
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/contrib/config"
+	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/config/configtelemetry"
 )
@@ -50,6 +51,33 @@ func TestLoadConfig(t *testing.T) {
 			},
 			success: true,
 		},
+		{
+			name: "valid logs component levels",
+			cfg: &Config{
+				Logs: LogsConfig{
+					ComponentLevels: map[string]zapcore.Level{
+						"exporter":        zapcore.WarnLevel,
+						"exporter/otlp/2": zapcore.DebugLevel,
+					},
+				},
+				Metrics: MetricsConfig{
+					Level: configtelemetry.LevelNone,
+				},
+			},
+			success: true,
+		},
+		{
+			name: "invalid logs component levels",
+			cfg: &Config{
+				Logs: LogsConfig{
+					ComponentLevels: map[string]zapcore.Level{"exporter/": zapcore.DebugLevel},
+				},
+				Metrics: MetricsConfig{
+					Level: configtelemetry.LevelNone,
+				},
+			},
+			success: false,
+		},
 	}
 
 	for _, tt := range tests {
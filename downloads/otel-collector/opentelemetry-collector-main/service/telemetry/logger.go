@@ -6,12 +6,16 @@ package telemetry // import "go.opentelemetry.io/collector/service/telemetry"
 import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/service/internal/loglevels"
 )
 
 func newLogger(cfg LogsConfig, options []zap.Option) (*zap.Logger, error) {
 	// Copied from NewProductionConfig.
+	// The entries are filtered by the level of the collector or of the component logging them,
+	// which can be lower than the level of the collector.
 	zapCfg := &zap.Config{
-		Level:             zap.NewAtomicLevelAt(cfg.Level),
+		Level:             zap.NewAtomicLevelAt(zapcore.DebugLevel),
 		Development:       cfg.Development,
 		Encoding:          cfg.Encoding,
 		EncoderConfig:     zap.NewProductionEncoderConfig(),
@@ -35,7 +39,11 @@ func newLogger(cfg LogsConfig, options []zap.Option) (*zap.Logger, error) {
 		logger = newSampledLogger(logger, cfg.Sampling)
 	}
 
-	return logger, nil
+	registry, err := loglevels.NewRegistry(cfg.Level, cfg.ComponentLevels)
+	if err != nil {
+		return nil, err
+	}
+	return logger.WithOptions(zap.WrapCore(registry.WrapCore)), nil
 }
 
 func newSampledLogger(logger *zap.Logger, sc *LogsSamplingConfig) *zap.Logger {
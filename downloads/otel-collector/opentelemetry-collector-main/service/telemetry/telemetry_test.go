@@ -49,6 +49,28 @@ func TestTelemetryConfiguration(t *testing.T) {
 			},
 			success: false,
 		},
+		{
+			name: "Valid component levels",
+			cfg: &Config{
+				Logs: LogsConfig{
+					Level:           zapcore.InfoLevel,
+					Encoding:        "console",
+					ComponentLevels: map[string]zapcore.Level{"exporter": zapcore.DebugLevel, "otlp/2": zapcore.WarnLevel},
+				},
+			},
+			success: true,
+		},
+		{
+			name: "Invalid component levels",
+			cfg: &Config{
+				Logs: LogsConfig{
+					Level:           zapcore.InfoLevel,
+					Encoding:        "console",
+					ComponentLevels: map[string]zapcore.Level{"otlp/": zapcore.DebugLevel},
+				},
+			},
+			success: false,
+		},
 	}
 
 	for _, tt := range tests {